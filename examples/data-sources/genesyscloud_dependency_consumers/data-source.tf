@@ -0,0 +1,14 @@
+data "genesyscloud_dependency_consumers" "support_queue" {
+  resource_type = "genesyscloud_routing_queue"
+  resource_id   = var.retired_queue_id
+  flow_filter   = "published"
+}
+
+resource "terraform_data" "retire_support_queue" {
+  lifecycle {
+    precondition {
+      condition     = length(data.genesyscloud_dependency_consumers.support_queue.consumers) == 0
+      error_message = "The queue is still used by ${join(", ", [for c in data.genesyscloud_dependency_consumers.support_queue.consumers : "${c.resource_type}.${c.resource_name}"])}"
+    }
+  }
+}
@@ -0,0 +1,9 @@
+data "genesyscloud_flow_validate" "inbound_call" {
+  filepath = "${path.module}/inboundcall_flow.yaml"
+  substitutions = {
+    flow_name  = "An example flow"
+    queue_name = genesyscloud_routing_queue.support.name
+  }
+  known_queue_names  = [genesyscloud_routing_queue.support.name, genesyscloud_routing_queue.sales.name]
+  known_prompt_names = [genesyscloud_architect_user_prompt.welcome.name]
+}
@@ -0,0 +1,4 @@
+data "genesyscloud_telephony_number_inventory" "inventory" {
+  did_pool_ids       = [genesyscloud_telephony_providers_edges_did_pool.did_pool.id]
+  include_unassigned = false
+}
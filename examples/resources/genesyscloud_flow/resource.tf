@@ -1,6 +1,9 @@
 resource "genesyscloud_flow" "flow" {
-  filepath          = "the flow configuration file path"
-  file_content_hash = filesha256("the flow configuration file path")
+  filepath              = "the flow configuration file path"
+  file_content_hash     = filesha256("the flow configuration file path")
+  version_history_count = 5
+  // Uncomment to roll back the flow to an earlier version, listed in versions
+  // published_version = "2.0"
   // Example flow configuration using substitutions:
   /*
   inboundCall:
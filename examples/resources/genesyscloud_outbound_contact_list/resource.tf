@@ -1,7 +1,13 @@
 resource "genesyscloud_outbound_contact_list" "contact-list" {
-  name             = "Example Contact List"
-  column_names     = ["First Name", "Last Name", "Cell", "Home"]
-  attempt_limit_id = genesyscloud_outbound_attempt_limit.attempt-limit.id
+  name                       = "Example Contact List"
+  column_names               = ["Contact ID", "First Name", "Last Name", "Cell", "Home"]
+  attempt_limit_id           = genesyscloud_outbound_attempt_limit.attempt-limit.id
+  contacts_filepath          = "${path.module}/contacts.csv"
+  contacts_file_content_hash = filesha256("${path.module}/contacts.csv")
+  contacts_id_name           = "Contact ID"
+  contacts_column_mapping = {
+    "Mobile" = "Cell"
+  }
   phone_columns {
     column_name = "Cell"
     type        = "cell"
@@ -3,8 +3,8 @@ package architect_datatable
 import (
 	"fmt"
 	"strconv"
-	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/cassette"
 	"testing"
 
 	"github.com/google/uuid"
@@ -27,7 +27,7 @@ func TestAccDataSourceArchitectDatatable(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
-		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		ProviderFactories: cassette.ProviderFactories(t, providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				// Create architect_datatable with a key and one other property
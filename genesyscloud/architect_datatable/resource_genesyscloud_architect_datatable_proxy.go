@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -12,6 +13,9 @@ import (
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *architectDatatableProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[architectDatatableProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOrUpdateArchitectDatatableFunc func(ctx context.Context, p *architectDatatableProxy, createAction bool, datatable *Datatable) (*Datatable, *platformclientv2.APIResponse, error)
 type deleteArchitectDatatableFunc func(ctx context.Context, p *architectDatatableProxy, datatableId string) (*platformclientv2.APIResponse, error)
@@ -40,11 +44,10 @@ func newArchitectDatatableProxy(clientConfig *platformclientv2.Configuration) *a
 }
 
 func getArchitectDatatableProxy(clientConfig *platformclientv2.Configuration) *architectDatatableProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectDatatableProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newArchitectDatatableProxy)
 }
 
 func (p *architectDatatableProxy) createArchitectDatatable(ctx context.Context, datatable *Datatable) (*Datatable, *platformclientv2.APIResponse, error) {
@@ -7,8 +7,8 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
-	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/cassette"
 	"testing"
 
 	"github.com/google/uuid"
@@ -47,7 +47,7 @@ func TestAccResourceArchitectDatatable(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
-		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		ProviderFactories: cassette.ProviderFactories(t, providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				// Create architect_datatable with a key and one other property
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"sync"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 
@@ -79,29 +78,7 @@ func customizeDatatableRowDiff(ctx context.Context, diff *schema.ResourceDiff, m
 	}
 
 	// For each property in the schema, check if a value is set in the config
-	if datatable.Schema != nil && datatable.Schema.Properties != nil {
-		for name, prop := range *datatable.Schema.Properties {
-			if name == "key" {
-				// Skip setting the key value
-				continue
-			}
-			if _, set := configMap[name]; !set {
-				// Property in schema not set. Override diff with expected default.
-				if prop.Default != nil {
-					configMap[name] = *prop.Default
-				} else if *prop.VarType == "boolean" {
-					// Booleans default to false
-					configMap[name] = false
-				} else if *prop.VarType == "string" {
-					// Strings default to empty
-					configMap[name] = ""
-				} else if *prop.VarType == "integer" || *prop.VarType == "number" {
-					// Numbers default to 0
-					configMap[name] = 0
-				}
-			}
-		}
-	}
+	applyDatatablePropertyDefaults(configMap, datatable)
 
 	// Marshal back to string and set as the diff value
 	result, err := json.Marshal(configMap)
@@ -113,13 +90,40 @@ func customizeDatatableRowDiff(ctx context.Context, diff *schema.ResourceDiff, m
 	return nil
 }
 
-// Prevent getting the architect_datatable schema on every row diff
-// by caching the results for the duration of the TF run
-var archDatatableCache sync.Map
+// applyDatatablePropertyDefaults sets the value the API defaults to on every property of the datatable schema that is
+// missing from the row
+func applyDatatablePropertyDefaults(configMap map[string]interface{}, datatable *Datatable) {
+	if datatable.Schema == nil || datatable.Schema.Properties == nil {
+		return
+	}
+	for name, prop := range *datatable.Schema.Properties {
+		if name == "key" {
+			// Skip setting the key value
+			continue
+		}
+		if _, set := configMap[name]; !set {
+			// Property in schema not set. Override diff with expected default.
+			if prop.Default != nil {
+				configMap[name] = *prop.Default
+			} else if *prop.VarType == "boolean" {
+				// Booleans default to false
+				configMap[name] = false
+			} else if *prop.VarType == "string" {
+				// Strings default to empty
+				configMap[name] = ""
+			} else if *prop.VarType == "integer" || *prop.VarType == "number" {
+				// Numbers default to 0
+				configMap[name] = 0
+			}
+		}
+	}
+}
 
+// Prevent getting the architect_datatable schema on every row diff
+// by caching the results on the provider's proxy for the duration of the TF run
 func getArchitectDatatableCached(ctx context.Context, tableID string, config *platformclientv2.Configuration) (*Datatable, error) {
 	archProxy := getArchitectDatatableRowProxy(config)
-	if table, ok := archDatatableCache.Load(tableID); ok {
+	if table, ok := archProxy.datatableSchemaCache.Load(tableID); ok {
 		return table.(*Datatable), nil
 	}
 
@@ -127,6 +131,6 @@ func getArchitectDatatableCached(ctx context.Context, tableID string, config *pl
 	if getErr != nil {
 		return nil, fmt.Errorf("Failed to read architect_datatable %s: %s", tableID, getErr)
 	}
-	archDatatableCache.Store(tableID, datatable)
+	archProxy.datatableSchemaCache.Store(tableID, datatable)
 	return datatable, nil
 }
@@ -0,0 +1,359 @@
+package architect_datatable_row
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+)
+
+// maxRowFileErrors is the number of validation errors reported for a rows file before the rest are omitted
+const maxRowFileErrors = 20
+
+// datatableRows maps the key of each row to its properties, without the key
+type datatableRows map[string]map[string]interface{}
+
+// rowsReconciliation lists the calls needed to make the rows of a datatable match the rows of a file
+type rowsReconciliation struct {
+	create []string
+	update []string
+	delete []string
+}
+
+// loadDatatableRowsFile reads the rows in a CSV or JSON file and validates them against the schema of the datatable.
+// The format is chosen by the file extension. Properties missing from a row are set to their default values.
+func loadDatatableRowsFile(path string, datatable *Datatable) (datatableRows, error) {
+	reader, file, err := files.DownloadOrOpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return parseDatatableRowsCsv(content, datatable)
+	case ".json":
+		return parseDatatableRowsJson(content, datatable)
+	}
+	return nil, fmt.Errorf("unsupported rows file %s, the file must have a .csv or .json extension", path)
+}
+
+// parseDatatableRowsCsv parses a CSV file with a header row naming the datatable properties. Cells are converted to
+// the type of their property. Empty boolean, integer and number cells are set to the property default, while empty
+// string cells are kept as empty strings.
+func parseDatatableRowsCsv(content []byte, datatable *Datatable) (datatableRows, error) {
+	records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV rows: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("CSV rows file must have a header row")
+	}
+
+	properties := datatableProperties(datatable)
+	header := records[0]
+	var errs []string
+	if !lists.ItemInSlice("key", header) {
+		errs = append(errs, "header: the key column is missing")
+	}
+	for _, column := range header {
+		if _, ok := properties[column]; !ok && column != "key" {
+			errs = append(errs, fmt.Sprintf("header: %q is not a property of the datatable", column))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, rowFileErrors(errs)
+	}
+
+	rows := make([]map[string]interface{}, 0, len(records)-1)
+	for i, record := range records[1:] {
+		row := make(map[string]interface{})
+		for j, column := range header {
+			cell := record[j]
+			propType := properties[column]
+			if column == "key" {
+				propType = "string"
+			}
+			if cell == "" && propType != "string" {
+				continue
+			}
+			value, err := parseDatatableCell(cell, propType)
+			if err != nil {
+				// Line numbers start at 1 and include the header
+				errs = append(errs, fmt.Sprintf("line %d: %s: %v", i+2, column, err))
+				continue
+			}
+			row[column] = value
+		}
+		rows = append(rows, row)
+	}
+	if len(errs) > 0 {
+		return nil, rowFileErrors(errs)
+	}
+	return buildDatatableRows(rows, datatable, "line", 2)
+}
+
+// parseDatatableRowsJson parses a JSON array of row objects, each with a key property
+func parseDatatableRowsJson(content []byte, datatable *Datatable) (datatableRows, error) {
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(content, &rows); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON rows, the file must contain an array of row objects: %v", err)
+	}
+	return buildDatatableRows(rows, datatable, "row", 1)
+}
+
+func parseDatatableCell(cell string, propType string) (interface{}, error) {
+	switch propType {
+	case "boolean":
+		return strconv.ParseBool(cell)
+	case "integer":
+		value, err := strconv.ParseInt(cell, 10, 64)
+		return float64(value), err
+	case "number":
+		return strconv.ParseFloat(cell, 64)
+	}
+	return cell, nil
+}
+
+// buildDatatableRows validates rows against the datatable schema and indexes them by key, using the same key rules
+// as a single genesyscloud_architect_datatable_row
+func buildDatatableRows(rows []map[string]interface{}, datatable *Datatable, position string, firstPosition int) (datatableRows, error) {
+	properties := datatableProperties(datatable)
+	result := make(datatableRows, len(rows))
+	var errs []string
+	for i, row := range rows {
+		location := fmt.Sprintf("%s %d", position, i+firstPosition)
+
+		keyStr, ok := row["key"].(string)
+		if !ok || keyStr == "" {
+			errs = append(errs, fmt.Sprintf("%s: key must be a non-empty string", location))
+			continue
+		}
+		if _, exists := result[keyStr]; exists {
+			errs = append(errs, fmt.Sprintf("%s: duplicate key %q", location, keyStr))
+			continue
+		}
+		for name, value := range row {
+			if name == "key" {
+				continue
+			}
+			propType, ok := properties[name]
+			if !ok {
+				errs = append(errs, fmt.Sprintf("%s: %q is not a property of the datatable", location, name))
+			} else if !datatableValueMatchesType(value, propType) {
+				errs = append(errs, fmt.Sprintf("%s: %s must be of type %s", location, name, propType))
+			}
+		}
+
+		delete(row, "key")
+		propertiesJson, err := json.Marshal(row)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", location, err))
+			continue
+		}
+		rowMap, diagErr := buildSdkRowPropertyMap(string(propertiesJson), keyStr)
+		if diagErr != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", location, diagErr[0].Summary))
+			continue
+		}
+		delete(rowMap, "key")
+		applyDatatablePropertyDefaults(rowMap, datatable)
+		result[keyStr] = normalizeDatatableRow(rowMap)
+	}
+	if len(errs) > 0 {
+		return nil, rowFileErrors(errs)
+	}
+	return result, nil
+}
+
+func datatableValueMatchesType(value interface{}, propType string) bool {
+	switch propType {
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	}
+	return true
+}
+
+// datatableProperties returns the type of each property in the datatable schema, except for the key
+func datatableProperties(datatable *Datatable) map[string]string {
+	properties := make(map[string]string)
+	if datatable.Schema == nil || datatable.Schema.Properties == nil {
+		return properties
+	}
+	for name, prop := range *datatable.Schema.Properties {
+		if name == "key" || prop.VarType == nil {
+			continue
+		}
+		properties[name] = *prop.VarType
+	}
+	return properties
+}
+
+// normalizeDatatableRow round trips a row through JSON so that rows from files and from the API can be compared
+func normalizeDatatableRow(row map[string]interface{}) map[string]interface{} {
+	normalized := make(map[string]interface{})
+	data, err := json.Marshal(row)
+	if err != nil {
+		return row
+	}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return row
+	}
+	return normalized
+}
+
+// datatableRowsFromApi indexes the rows returned by the API by key
+func datatableRowsFromApi(apiRows []map[string]interface{}) datatableRows {
+	rows := make(datatableRows, len(apiRows))
+	for _, apiRow := range apiRows {
+		keyStr, ok := apiRow["key"].(string)
+		if !ok {
+			continue
+		}
+		row := make(map[string]interface{}, len(apiRow))
+		for name, value := range apiRow {
+			if name != "key" {
+				row[name] = value
+			}
+		}
+		rows[keyStr] = normalizeDatatableRow(row)
+	}
+	return rows
+}
+
+// reconcileDatatableRows returns the keys of the rows that must be created, updated and deleted for the current rows
+// to match the desired rows. Rows that are the same in both are left alone.
+func reconcileDatatableRows(current datatableRows, desired datatableRows) rowsReconciliation {
+	var r rowsReconciliation
+	for keyStr, row := range desired {
+		currentRow, exists := current[keyStr]
+		if !exists {
+			r.create = append(r.create, keyStr)
+		} else if !reflect.DeepEqual(currentRow, row) {
+			r.update = append(r.update, keyStr)
+		}
+	}
+	for keyStr := range current {
+		if _, exists := desired[keyStr]; !exists {
+			r.delete = append(r.delete, keyStr)
+		}
+	}
+	sort.Strings(r.create)
+	sort.Strings(r.update)
+	sort.Strings(r.delete)
+	return r
+}
+
+func (r rowsReconciliation) isEmpty() bool {
+	return r.count() == 0
+}
+
+// count is the number of rows to create, update and delete
+func (r rowsReconciliation) count() int {
+	return len(r.create) + len(r.update) + len(r.delete)
+}
+
+// datatableApiRow returns a row of a rows file with its key, as it is sent to the API
+func datatableApiRow(keyStr string, row map[string]interface{}) map[string]interface{} {
+	apiRow := make(map[string]interface{}, len(row)+1)
+	for name, value := range row {
+		apiRow[name] = value
+	}
+	apiRow["key"] = keyStr
+	return apiRow
+}
+
+// writeDatatableRowsCsv writes rows as a CSV file that parseDatatableRowsCsv reads back to the same rows. The key
+// column comes first, followed by the properties in their display order.
+func writeDatatableRowsCsv(w io.Writer, rows datatableRows, datatable *Datatable) error {
+	columns := []string{"key"}
+	if datatable.Schema != nil && datatable.Schema.Properties != nil {
+		propertyNames := make([]string, 0, len(*datatable.Schema.Properties))
+		for name := range *datatable.Schema.Properties {
+			if name != "key" {
+				propertyNames = append(propertyNames, name)
+			}
+		}
+		displayOrder := func(name string) int {
+			if order := (*datatable.Schema.Properties)[name].DisplayOrder; order != nil {
+				return *order
+			}
+			return math.MaxInt
+		}
+		sort.Slice(propertyNames, func(i, j int) bool {
+			if displayOrder(propertyNames[i]) != displayOrder(propertyNames[j]) {
+				return displayOrder(propertyNames[i]) < displayOrder(propertyNames[j])
+			}
+			return propertyNames[i] < propertyNames[j]
+		})
+		columns = append(columns, propertyNames...)
+	}
+
+	keys := make([]string, 0, len(rows))
+	for keyStr := range rows {
+		keys = append(keys, keyStr)
+	}
+	sort.Strings(keys)
+
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(columns); err != nil {
+		return err
+	}
+	for _, keyStr := range keys {
+		record := []string{keyStr}
+		for _, column := range columns[1:] {
+			record = append(record, formatDatatableCell(rows[keyStr][column]))
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+func formatDatatableCell(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func rowFileErrors(errs []string) error {
+	if len(errs) > maxRowFileErrors {
+		errs = append(errs[:maxRowFileErrors], fmt.Sprintf("and %d more errors", len(errs)-maxRowFileErrors))
+	}
+	return fmt.Errorf("invalid rows file:\n%s", strings.Join(errs, "\n"))
+}
@@ -6,14 +6,22 @@ import (
 	"errors"
 	"github.com/mitchellh/mapstructure"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"io"
 	"log"
 	"net/http"
+	"os"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
 )
 
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *architectDatatableRowProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[architectDatatableRowProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getArchitectDatatableFunc func(ctx context.Context, p *architectDatatableRowProxy, datatableId string, expanded string) (*Datatable, *platformclientv2.APIResponse, error)
 type getAllArchitectDatatableFunc func(ctx context.Context, p *architectDatatableRowProxy) (*[]platformclientv2.Datatable, *platformclientv2.APIResponse, error)
@@ -22,6 +30,9 @@ type getArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatabl
 type createArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatableRowProxy, tableId string, row *map[string]interface{}) (*map[string]interface{}, *platformclientv2.APIResponse, error)
 type updateArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatableRowProxy, tableId string, key string, row *map[string]interface{}) (*map[string]interface{}, *platformclientv2.APIResponse, error)
 type deleteArchitectDatatableRowFunc func(ctx context.Context, p *architectDatatableRowProxy, tableId string, rowId string) (*platformclientv2.APIResponse, error)
+type createArchitectDatatableImportJobFunc func(ctx context.Context, p *architectDatatableRowProxy, tableId string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error)
+type uploadArchitectDatatableImportFileFunc func(ctx context.Context, p *architectDatatableRowProxy, uploadUri string, rowsCsv []byte) ([]byte, error)
+type getArchitectDatatableImportJobFunc func(ctx context.Context, p *architectDatatableRowProxy, tableId string, importJobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error)
 
 type architectDatatableRowProxy struct {
 	clientConfig                     *platformclientv2.Configuration
@@ -35,17 +46,30 @@ type architectDatatableRowProxy struct {
 	deleteArchitectDatatableRowAttr  deleteArchitectDatatableRowFunc
 	dataTableRowCache                rc.CacheInterface[map[string]interface{}]
 	dataTableCache                   rc.CacheInterface[Datatable]
+	// Datatables listed for exports, and the rows of each datatable by table id
+	dataTableListCache rc.CacheInterface[platformclientv2.Datatable]
+	dataTableRowsCache rc.CacheInterface[[]map[string]interface{}]
+	// Datatable schemas by table id, looked up on row diffs
+	datatableSchemaCache sync.Map
+
+	createArchitectDatatableImportJobAttr  createArchitectDatatableImportJobFunc
+	uploadArchitectDatatableImportFileAttr uploadArchitectDatatableImportFileFunc
+	getArchitectDatatableImportJobAttr     getArchitectDatatableImportJobFunc
 }
 
 func newArchitectDatatableRowProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	dataTableRowCache := rc.NewResourceCache[map[string]interface{}]()
-	dataTableCache := rc.NewResourceCache[Datatable]()
+	dataTableRowCache := rc.NewNamedClientResourceCache[map[string]interface{}](clientConfig, "datatable_row")
+	dataTableCache := rc.NewClientResourceCache[Datatable](clientConfig)
+	dataTableListCache := rc.NewClientResourceCache[platformclientv2.Datatable](clientConfig)
+	dataTableRowsCache := rc.NewNamedClientResourceCache[[]map[string]interface{}](clientConfig, "datatable_rows")
 	return &architectDatatableRowProxy{
 		clientConfig:                     clientConfig,
 		architectApi:                     api,
 		dataTableRowCache:                dataTableRowCache,
 		dataTableCache:                   dataTableCache,
+		dataTableListCache:               dataTableListCache,
+		dataTableRowsCache:               dataTableRowsCache,
 		getArchitectDatatableAttr:        getArchitectDatatableFn,
 		getAllArchitectDatatableAttr:     getAllArchitectDatatableFn,
 		getAllArchitectDatatableRowsAttr: getAllArchitectDatatableRowsFn,
@@ -53,14 +77,18 @@ func newArchitectDatatableRowProxy(clientConfig *platformclientv2.Configuration)
 		createArchitectDatatableRowAttr:  createArchitectDatatableRowFn,
 		updateArchitectDatatableRowAttr:  updateArchitectDatatableRowFn,
 		deleteArchitectDatatableRowAttr:  deleteArchitectDatatableRowFn,
+
+		createArchitectDatatableImportJobAttr:  createArchitectDatatableImportJobFn,
+		uploadArchitectDatatableImportFileAttr: uploadArchitectDatatableImportFileFn,
+		getArchitectDatatableImportJobAttr:     getArchitectDatatableImportJobFn,
 	}
 }
 
 func getArchitectDatatableRowProxy(clientConfig *platformclientv2.Configuration) *architectDatatableRowProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectDatatableRowProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newArchitectDatatableRowProxy)
 }
 
 func (p *architectDatatableRowProxy) getArchitectDatatable(ctx context.Context, id string, expanded string) (*Datatable, *platformclientv2.APIResponse, error) {
@@ -91,7 +119,23 @@ func (p *architectDatatableRowProxy) deleteArchitectDatatableRow(ctx context.Con
 	return p.deleteArchitectDatatableRowAttr(ctx, p, tableId, rowId)
 }
 
+func (p *architectDatatableRowProxy) createArchitectDatatableImportJob(ctx context.Context, tableId string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.createArchitectDatatableImportJobAttr(ctx, p, tableId, importMode)
+}
+
+func (p *architectDatatableRowProxy) uploadArchitectDatatableImportFile(ctx context.Context, uploadUri string, rowsCsv []byte) ([]byte, error) {
+	return p.uploadArchitectDatatableImportFileAttr(ctx, p, uploadUri, rowsCsv)
+}
+
+func (p *architectDatatableRowProxy) getArchitectDatatableImportJob(ctx context.Context, tableId string, importJobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.getArchitectDatatableImportJobAttr(ctx, p, tableId, importJobId)
+}
+
 func getAllArchitectDatatableFn(_ context.Context, p *architectDatatableRowProxy) (*[]platformclientv2.Datatable, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.dataTableListCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	var totalRecords []platformclientv2.Datatable
 
 	const pageSize = 100
@@ -107,6 +151,7 @@ func getAllArchitectDatatableFn(_ context.Context, p *architectDatatableRowProxy
 	for _, table := range *tables.Entities {
 		totalRecords = append(totalRecords, table)
 		rc.SetCache(p.dataTableCache, *table.Id, *ConvertDatatable(table))
+		rc.SetCache(p.dataTableListCache, *table.Id, table)
 	}
 
 	for pageNum := 2; pageNum <= *tables.PageCount; pageNum++ {
@@ -122,8 +167,10 @@ func getAllArchitectDatatableFn(_ context.Context, p *architectDatatableRowProxy
 		for _, table := range *tables.Entities {
 			totalRecords = append(totalRecords, table)
 			rc.SetCache(p.dataTableCache, *table.Id, *ConvertDatatable(table))
+			rc.SetCache(p.dataTableListCache, *table.Id, table)
 		}
 	}
+	rc.SetCacheComplete(p.dataTableListCache)
 	return &totalRecords, apiResponse, nil
 }
 
@@ -139,8 +186,9 @@ func ConvertDatatable(master platformclientv2.Datatable) *Datatable {
 
 func getArchitectDatatableFn(_ context.Context, p *architectDatatableRowProxy, datatableId string, expanded string) (*Datatable, *platformclientv2.APIResponse, error) {
 
+	// Datatables are cached without their schema when they are listed
 	eg := rc.GetCacheItem(p.dataTableCache, datatableId)
-	if eg != nil {
+	if eg != nil && (expanded != "schema" || eg.Schema != nil) {
 		return eg, nil, nil
 	}
 
@@ -179,6 +227,11 @@ func getArchitectDatatableFn(_ context.Context, p *architectDatatableRowProxy, d
 }
 
 func getAllArchitectDatatableRowsFn(_ context.Context, p *architectDatatableRowProxy, tableId string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error) {
+	// Each cached item holds all the rows of a datatable
+	if cached := rc.GetCacheItem(p.dataTableRowsCache, tableId); cached != nil {
+		return cached, nil, nil
+	}
+
 	var resources []map[string]interface{}
 	const pageSize = 100
 
@@ -188,6 +241,7 @@ func getAllArchitectDatatableRowsFn(_ context.Context, p *architectDatatableRowP
 	}
 
 	if rows.Entities == nil || len(*rows.Entities) == 0 {
+		rc.SetCache(p.dataTableRowsCache, tableId, resources)
 		return &resources, apiResponse, nil
 	}
 
@@ -216,6 +270,7 @@ func getAllArchitectDatatableRowsFn(_ context.Context, p *architectDatatableRowP
 			}
 		}
 	}
+	rc.SetCache(p.dataTableRowsCache, tableId, resources)
 	return &resources, apiResponse, nil
 }
 
@@ -238,3 +293,38 @@ func updateArchitectDatatableRowFn(_ context.Context, p *architectDatatableRowPr
 func deleteArchitectDatatableRowFn(_ context.Context, p *architectDatatableRowProxy, tableId string, rowId string) (*platformclientv2.APIResponse, error) {
 	return p.architectApi.DeleteFlowsDatatableRow(tableId, rowId)
 }
+
+func createArchitectDatatableImportJobFn(_ context.Context, p *architectDatatableRowProxy, tableId string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.architectApi.PostFlowsDatatableImportJobs(tableId, platformclientv2.Datatableimportjob{ImportMode: &importMode})
+}
+
+// uploadArchitectDatatableImportFileFn uploads a CSV file of rows to the upload URI of an import job
+func uploadArchitectDatatableImportFileFn(_ context.Context, p *architectDatatableRowProxy, uploadUri string, rowsCsv []byte) ([]byte, error) {
+	// The rows are sent as a file part of the form, which needs a named file
+	rowsFile, err := os.CreateTemp("", "datatable-rows-*.csv")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(rowsFile.Name())
+	if _, err := rowsFile.Write(rowsCsv); err != nil {
+		rowsFile.Close()
+		return nil, err
+	}
+	if _, err := rowsFile.Seek(0, io.SeekStart); err != nil {
+		rowsFile.Close()
+		return nil, err
+	}
+
+	formData := make(map[string]io.Reader)
+	formData["file"] = rowsFile
+
+	headers := make(map[string]string)
+	headers["Authorization"] = "Bearer " + p.clientConfig.AccessToken
+
+	s3Uploader := files.NewS3Uploader(nil, formData, nil, headers, http.MethodPost, uploadUri)
+	return s3Uploader.Upload()
+}
+
+func getArchitectDatatableImportJobFn(_ context.Context, p *architectDatatableRowProxy, tableId string, importJobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+	return p.architectApi.GetFlowsDatatableImportJob(tableId, importJobId)
+}
@@ -6,15 +6,24 @@ import (
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
 )
 
-const resourceName = "genesyscloud_architect_datatable_row"
+const (
+	resourceName     = "genesyscloud_architect_datatable_row"
+	rowsResourceName = "genesyscloud_architect_datatable_rows"
+
+	// Sub directory of the export the rows files are written to
+	datatableRowsSubDirectory = "datatables"
+)
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(resourceName, ResourceArchitectDatatableRow())
 	//No Datasource defined
 	regInstance.RegisterExporter(resourceName, ArchitectDatatableRowExporter())
+	regInstance.RegisterResource(rowsResourceName, ResourceArchitectDatatableRows())
+	regInstance.RegisterExporter(rowsResourceName, ArchitectDatatableRowsExporter())
 }
 
 func ArchitectDatatableRowExporter() *resourceExporter.ResourceExporter {
@@ -63,3 +72,56 @@ func ResourceArchitectDatatableRow() *schema.Resource {
 		CustomizeDiff: customizeDatatableRowDiff,
 	}
 }
+
+func ArchitectDatatableRowsExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: provider.GetAllWithPooledClient(getAllArchitectDatatablesWithRows),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"datatable_id": {RefType: "genesyscloud_architect_datatable"},
+		},
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: ArchitectDatatableRowsResolver,
+			SubDirectory:              datatableRowsSubDirectory,
+		},
+		ReplacesResourceTypes: []string{resourceName},
+	}
+}
+
+func ResourceArchitectDatatableRows() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Architect Datatable Rows. Manages all the rows of a datatable from a CSV or JSON file. When the rows of the datatable differ from the file, up to 50 missing, changed or extra rows are created, updated or deleted one by one. When more rows differ, all the rows are replaced with the rows of the file in a single import job. Rows that are not in the file are deleted, so this resource must not be used together with genesyscloud_architect_datatable_row resources for the same datatable.
+
+A CSV file has a header row naming the datatable properties, including a 'key' column. Empty boolean, integer and number cells are set to the property default. A JSON file contains an array of row objects, each with a 'key' property. The file is validated against the datatable schema during the plan.
+
+The resource is imported with the datatable ID, or with the path of the rows file and the datatable ID separated by a comma (<filepath>,<datatable_id>). With the path, the file content hash is set to the SHA256 hash of the file, as computed by filesha256, so that the imported resource has no changes if the datatable has the rows of the file.`,
+
+		CreateContext: provider.CreateWithPooledClient(createArchitectDatatableRows),
+		ReadContext:   provider.ReadWithPooledClient(readArchitectDatatableRows),
+		UpdateContext: provider.UpdateWithPooledClient(updateArchitectDatatableRows),
+		DeleteContext: provider.DeleteWithPooledClient(deleteArchitectDatatableRows),
+		Importer: &schema.ResourceImporter{
+			StateContext: importArchitectDatatableRows,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"datatable_id": {
+				Description: "ID of the datatable whose rows are managed. If this is changed, the rows of the previous datatable are deleted.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"filepath": {
+				Description:  "Path to the CSV (.csv) or JSON (.json) file containing the rows of the datatable.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"file_content_hash": {
+				Description: "Hash value of the rows file content. Used to detect changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+		CustomizeDiff: customizeDatatableRowsDiff,
+	}
+}
@@ -0,0 +1,384 @@
+package architect_datatable_row
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+var (
+	datatableImportPollInterval = 2 * time.Second
+	datatableImportTimeout      = 30 * time.Minute
+
+	// Rows are changed one API call at a time up to this number of changes, above it all the rows are replaced
+	// with a single import job
+	datatableRowsMaxRowCalls = 50
+)
+
+func getAllArchitectDatatablesWithRows(ctx context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	archProxy := getArchitectDatatableRowProxy(clientConfig)
+
+	tables, resp, err := archProxy.getAllArchitectDatatable(ctx)
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to get architect datatables error: %s", err), resp)
+	}
+
+	for _, tableMeta := range *tables {
+		// Imported with the path of its exported rows file, so that the file doesn't show as a change
+		resources[*tableMeta.Id] = &resourceExporter.ResourceMeta{
+			Name:     *tableMeta.Name,
+			IdPrefix: path.Join(datatableRowsSubDirectory, datatableRowsExportFileName(*tableMeta.Id)) + ",",
+		}
+	}
+	return resources, nil
+}
+
+func createArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableId := d.Get("datatable_id").(string)
+	log.Printf("Creating Datatable Rows for table %s", tableId)
+
+	if diagErr := reconcileArchitectDatatableRows(ctx, d, meta); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(tableId)
+	log.Printf("Created Datatable Rows for table %s", tableId)
+	return readArchitectDatatableRows(ctx, d, meta)
+}
+
+func readArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableId := d.Id()
+	filePath := d.Get("filepath").(string)
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	archProxy := getArchitectDatatableRowProxy(sdkConfig)
+
+	log.Printf("Reading Datatable Rows for table %s", tableId)
+
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		apiRows, resp, getErr := archProxy.getAllArchitectDatatableRows(ctx, tableId)
+		if getErr != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to read Datatable Rows %s | error: %s", tableId, getErr), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to read Datatable Rows %s | error: %s", tableId, getErr), resp))
+		}
+
+		_ = d.Set("datatable_id", tableId)
+
+		// Rows are compared with the file rather than stored in state. If they were changed outside of Terraform,
+		// the hash is cleared so that the next apply puts the rows of the file back.
+		if filePath != "" {
+			datatable, err := getArchitectDatatableCached(ctx, tableId, sdkConfig)
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+			fileRows, err := loadDatatableRowsFile(filePath, datatable)
+			if err != nil {
+				log.Printf("Unable to compare the rows of datatable %s with %s: %v", tableId, filePath, err)
+			} else if changes := reconcileDatatableRows(datatableRowsFromApi(*apiRows), fileRows); !changes.isEmpty() {
+				log.Printf("Datatable %s has %d missing, %d changed and %d extra rows compared to %s", tableId, len(changes.create), len(changes.update), len(changes.delete), filePath)
+				setFileContentHashToNil(d)
+			}
+		}
+
+		log.Printf("Read Datatable Rows for table %s", tableId)
+		return nil
+	})
+}
+
+func updateArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating Datatable Rows for table %s", d.Id())
+
+	if diagErr := reconcileArchitectDatatableRows(ctx, d, meta); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated Datatable Rows for table %s", d.Id())
+	return readArchitectDatatableRows(ctx, d, meta)
+}
+
+// Delete every row of the datatable, as the resource manages all of them
+func deleteArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableId := d.Id()
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	archProxy := getArchitectDatatableRowProxy(sdkConfig)
+
+	log.Printf("Deleting Datatable Rows for table %s", tableId)
+	datatable, resp, err := archProxy.getArchitectDatatable(ctx, tableId, "schema")
+	if err != nil {
+		if util.IsStatus404(resp) {
+			// Parent architect_datatable was probably deleted which caused the rows to be deleted
+			log.Printf("Datatable %s already deleted", tableId)
+			return nil
+		}
+		return util.BuildAPIDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to read architect_datatable %s error: %s", tableId, err), resp)
+	}
+
+	// Importing a file without rows replaces all the rows of the datatable with none
+	if diagErr := importArchitectDatatableRowsFile(ctx, archProxy, tableId, datatableRows{}, datatable); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Deleted Datatable Rows for table %s", tableId)
+	return nil
+}
+
+// reconcileArchitectDatatableRows makes the rows of the datatable match the rows file. A few missing, changed or
+// extra rows are created, updated or deleted one by one, more are replaced all at once with an import job.
+func reconcileArchitectDatatableRows(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableId := d.Get("datatable_id").(string)
+	filePath := d.Get("filepath").(string)
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	archProxy := getArchitectDatatableRowProxy(sdkConfig)
+
+	datatable, err := getArchitectDatatableCached(ctx, tableId, sdkConfig)
+	if err != nil {
+		setFileContentHashToNil(d)
+		return util.BuildDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to read architect_datatable %s", tableId), err)
+	}
+	fileRows, err := loadDatatableRowsFile(filePath, datatable)
+	if err != nil {
+		setFileContentHashToNil(d)
+		return util.BuildDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to load rows for datatable %s from %s", tableId, filePath), err)
+	}
+
+	apiRows, resp, err := archProxy.getAllArchitectDatatableRows(ctx, tableId)
+	if err != nil {
+		setFileContentHashToNil(d)
+		return util.BuildAPIDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to get rows of datatable %s error: %s", tableId, err), resp)
+	}
+
+	changes := reconcileDatatableRows(datatableRowsFromApi(*apiRows), fileRows)
+	if changes.isEmpty() {
+		log.Printf("Rows of datatable %s already match %s", tableId, filePath)
+		return nil
+	}
+	log.Printf("Reconciling datatable %s: %d rows to create, %d to update and %d to delete", tableId, len(changes.create), len(changes.update), len(changes.delete))
+
+	var diagErr diag.Diagnostics
+	if changes.count() <= datatableRowsMaxRowCalls {
+		diagErr = applyArchitectDatatableRowChanges(ctx, archProxy, tableId, changes, fileRows)
+	} else {
+		diagErr = importArchitectDatatableRowsFile(ctx, archProxy, tableId, fileRows, datatable)
+	}
+	if diagErr != nil {
+		setFileContentHashToNil(d)
+		return diagErr
+	}
+	return nil
+}
+
+// applyArchitectDatatableRowChanges creates, updates and deletes the changed rows of a datatable one by one
+func applyArchitectDatatableRowChanges(ctx context.Context, archProxy *architectDatatableRowProxy, tableId string, changes rowsReconciliation, rows datatableRows) diag.Diagnostics {
+	for _, keyStr := range changes.create {
+		row := datatableApiRow(keyStr, rows[keyStr])
+		if _, resp, err := archProxy.createArchitectDatatableRow(ctx, tableId, &row); err != nil {
+			return util.BuildAPIDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to create row %s of datatable %s error: %s", keyStr, tableId, err), resp)
+		}
+	}
+	for _, keyStr := range changes.update {
+		row := datatableApiRow(keyStr, rows[keyStr])
+		if _, resp, err := archProxy.updateArchitectDatatableRow(ctx, tableId, keyStr, &row); err != nil {
+			return util.BuildAPIDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to update row %s of datatable %s error: %s", keyStr, tableId, err), resp)
+		}
+	}
+	for _, keyStr := range changes.delete {
+		if resp, err := archProxy.deleteArchitectDatatableRow(ctx, tableId, keyStr); err != nil && !util.IsStatus404(resp) {
+			return util.BuildAPIDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to delete row %s of datatable %s error: %s", keyStr, tableId, err), resp)
+		}
+	}
+	return nil
+}
+
+// importArchitectDatatableRowsFile replaces all the rows of a datatable with the given rows in a single import job
+func importArchitectDatatableRowsFile(ctx context.Context, archProxy *architectDatatableRowProxy, tableId string, rows datatableRows, datatable *Datatable) diag.Diagnostics {
+	var rowsCsv bytes.Buffer
+	if err := writeDatatableRowsCsv(&rowsCsv, rows, datatable); err != nil {
+		return util.BuildDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to build the rows file of datatable %s", tableId), err)
+	}
+
+	importJob, resp, err := archProxy.createArchitectDatatableImportJob(ctx, tableId, "ReplaceAll")
+	if err != nil {
+		return util.BuildAPIDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to create an import job for datatable %s error: %s", tableId, err), resp)
+	}
+	if importJob.Id == nil || importJob.UploadURI == nil {
+		return util.BuildDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to create an import job for datatable %s", tableId), fmt.Errorf("the import job has no upload URI"))
+	}
+
+	if _, err := archProxy.uploadArchitectDatatableImportFile(ctx, *importJob.UploadURI, rowsCsv.Bytes()); err != nil {
+		return util.BuildDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to upload the rows of datatable %s", tableId), err)
+	}
+	return waitForDatatableImportJob(ctx, archProxy, tableId, *importJob.Id)
+}
+
+// waitForDatatableImportJob polls an import job of a datatable until it has succeeded or failed
+func waitForDatatableImportJob(ctx context.Context, archProxy *architectDatatableRowProxy, tableId string, importJobId string) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, datatableImportTimeout)
+	defer cancel()
+
+	for {
+		// The import is started asynchronously once the file is uploaded
+		select {
+		case <-ctx.Done():
+			return util.BuildDiagnosticError(rowsResourceName, fmt.Sprintf("Timed out waiting for import job %s of datatable %s", importJobId, tableId), ctx.Err())
+		case <-time.After(datatableImportPollInterval):
+		}
+
+		importJob, resp, err := archProxy.getArchitectDatatableImportJob(ctx, tableId, importJobId)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to get import job %s of datatable %s error: %s", importJobId, tableId, err), resp)
+		}
+		if importJob.Status == nil {
+			continue
+		}
+
+		switch *importJob.Status {
+		case "Succeeded":
+			return nil
+		case "Failed":
+			reason := "unknown reason"
+			if importJob.ErrorInformation != nil && importJob.ErrorInformation.Message != nil {
+				reason = *importJob.ErrorInformation.Message
+			}
+			return util.BuildDiagnosticError(rowsResourceName, fmt.Sprintf("Failed to import the rows of datatable %s", tableId), errors.New(reason))
+		default:
+			if importJob.CountRecordsUpdated != nil {
+				log.Printf("Importing rows into datatable %s: %d rows processed", tableId, *importJob.CountRecordsUpdated)
+			}
+		}
+	}
+}
+
+// datatableRowsFileHash returns the SHA256 hash of a rows file, the same as the filesha256 function of Terraform
+func datatableRowsFileHash(filePath string) (string, error) {
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// importArchitectDatatableRows imports the rows of a datatable by its ID or by the path of its rows file followed
+// by its ID (<filepath>,<datatable_id>). With the path, the file and its hash are set so that the imported resource
+// has no changes if the rows of the file are the rows of the datatable.
+func importArchitectDatatableRows(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	importId := d.Id()
+	separator := strings.LastIndex(importId, ",")
+	if separator == -1 {
+		d.SetId(importId)
+		return []*schema.ResourceData{d}, nil
+	}
+
+	filePath, tableId := importId[:separator], importId[separator+1:]
+	if filePath == "" || tableId == "" {
+		return nil, fmt.Errorf("invalid datatable rows import ID %s, expected <filepath>,<datatable_id>", importId)
+	}
+	_ = d.Set("filepath", filePath)
+	if hash, err := datatableRowsFileHash(filePath); err != nil {
+		log.Printf("Unable to hash the rows file %s of datatable %s: %v", filePath, tableId, err)
+	} else {
+		_ = d.Set("file_content_hash", hash)
+	}
+	d.SetId(tableId)
+	return []*schema.ResourceData{d}, nil
+}
+
+// customizeDatatableRowsDiff validates the rows file against the datatable schema during the plan
+func customizeDatatableRowsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("datatable_id") || !diff.NewValueKnown("filepath") {
+		return nil
+	}
+	tableId := diff.Get("datatable_id").(string)
+	filePath := diff.Get("filepath").(string)
+	if tableId == "" || filePath == "" {
+		return nil
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	datatable, err := getArchitectDatatableCached(ctx, tableId, sdkConfig)
+	if err != nil {
+		return err
+	}
+	if _, err := loadDatatableRowsFile(filePath, datatable); err != nil {
+		return fmt.Errorf("rows file %s does not match the schema of datatable %s: %v", filePath, tableId, err)
+	}
+	return nil
+}
+
+// ArchitectDatatableRowsResolver writes the rows of a datatable to a CSV file in the sub directory of the export
+func ArchitectDatatableRowsResolver(tableId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}) error {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	archProxy := getArchitectDatatableRowProxy(sdkConfig)
+	ctx := context.Background()
+
+	exportFileName := datatableRowsExportFileName(tableId)
+
+	fullPath := path.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	datatable, err := getArchitectDatatableCached(ctx, tableId, sdkConfig)
+	if err != nil {
+		return err
+	}
+	apiRows, resp, err := archProxy.getAllArchitectDatatableRows(ctx, tableId)
+	if err != nil {
+		return fmt.Errorf("failed to get rows of datatable %s: %v %v", tableId, err, resp)
+	}
+
+	var csvRows bytes.Buffer
+	if err := writeDatatableRowsCsv(&csvRows, datatableRowsFromApi(*apiRows), datatable); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path.Join(fullPath, exportFileName), csvRows.Bytes(), 0644); err != nil {
+		return err
+	}
+
+	// Update filepath field in configMap to point to exported rows file
+	configMap["filepath"] = path.Join(subDirectory, exportFileName)
+	configMap["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, path.Join(subDirectory, exportFileName))
+
+	return nil
+}
+
+func datatableRowsExportFileName(tableId string) string {
+	return fmt.Sprintf("datatable-%s.csv", tableId)
+}
+
+// setFileContentHashToNil makes Terraform detect a change of the file content hash after a failed or partial update,
+// so that the rows are reconciled again on the next apply even if the file is unchanged
+func setFileContentHashToNil(d *schema.ResourceData) {
+	_ = d.Set("file_content_hash", nil)
+}
@@ -0,0 +1,268 @@
+package architect_datatable_row
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRowsDatatable(tableId string) *Datatable {
+	property := func(varType string, displayOrder int, defaultValue interface{}) Datatableproperty {
+		prop := Datatableproperty{VarType: &varType, DisplayOrder: &displayOrder}
+		if defaultValue != nil {
+			prop.Default = &defaultValue
+		}
+		return prop
+	}
+	return &Datatable{
+		Id: &tableId,
+		Schema: &Jsonschemadocument{
+			Properties: &map[string]Datatableproperty{
+				"key":     property("string", 0, nil),
+				"name":    property("string", 1, nil),
+				"enabled": property("boolean", 2, true),
+				"count":   property("integer", 3, nil),
+				"ratio":   property("number", 4, nil),
+			},
+		},
+	}
+}
+
+func TestUnitParseDatatableRowsCsv(t *testing.T) {
+	datatable := testRowsDatatable(uuid.NewString())
+
+	rows, err := parseDatatableRowsCsv([]byte("key,name,enabled,count,ratio\nfr,\"Paris, France\",false,3,0.5\nde,,,,\n"), datatable)
+	assert.Nil(t, err)
+	assert.Equal(t, datatableRows{
+		"fr": {"name": "Paris, France", "enabled": false, "count": float64(3), "ratio": 0.5},
+		"de": {"name": "", "enabled": true, "count": float64(0), "ratio": float64(0)},
+	}, rows)
+
+	// Columns missing from the file are set to their default values
+	rows, err = parseDatatableRowsCsv([]byte("key,count\nfr,3\n"), datatable)
+	assert.Nil(t, err)
+	assert.Equal(t, datatableRows{"fr": {"name": "", "enabled": true, "count": float64(3), "ratio": float64(0)}}, rows)
+
+	_, err = parseDatatableRowsCsv([]byte("name,city\nParis,Paris\n"), datatable)
+	assert.EqualError(t, err, "invalid rows file:\nheader: the key column is missing\nheader: \"city\" is not a property of the datatable")
+
+	_, err = parseDatatableRowsCsv([]byte("key,enabled,count\nfr,maybe,1.5\nfr,true,1\n,true,1\n"), datatable)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "line 2: enabled:")
+	assert.Contains(t, err.Error(), "line 2: count:")
+
+	_, err = parseDatatableRowsCsv([]byte("key,count\nfr,1\nfr,2\n,3\n"), datatable)
+	assert.EqualError(t, err, "invalid rows file:\nline 3: duplicate key \"fr\"\nline 4: key must be a non-empty string")
+}
+
+func TestUnitParseDatatableRowsJson(t *testing.T) {
+	datatable := testRowsDatatable(uuid.NewString())
+
+	rows, err := parseDatatableRowsJson([]byte(`[{"key": "fr", "name": "Paris", "count": 3}]`), datatable)
+	assert.Nil(t, err)
+	assert.Equal(t, datatableRows{"fr": {"name": "Paris", "enabled": true, "count": float64(3), "ratio": float64(0)}}, rows)
+
+	_, err = parseDatatableRowsJson([]byte(`[{"key": "fr", "count": 1.5, "enabled": "yes", "city": "Paris"}]`), datatable)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "row 1: count must be of type integer")
+	assert.Contains(t, err.Error(), "row 1: enabled must be of type boolean")
+	assert.Contains(t, err.Error(), "row 1: \"city\" is not a property of the datatable")
+
+	_, err = parseDatatableRowsJson([]byte(`{"key": "fr"}`), datatable)
+	assert.NotNil(t, err)
+}
+
+func TestUnitRowFileErrors(t *testing.T) {
+	errs := make([]string, maxRowFileErrors+5)
+	for i := range errs {
+		errs[i] = "error"
+	}
+	assert.Contains(t, rowFileErrors(errs).Error(), "\nand 5 more errors")
+}
+
+func TestUnitWriteDatatableRowsCsv(t *testing.T) {
+	datatable := testRowsDatatable(uuid.NewString())
+	rows := datatableRows{
+		"fr": {"name": "Paris, France", "enabled": false, "count": float64(3), "ratio": 0.25},
+		"de": {"name": "Berlin", "enabled": true, "count": float64(12), "ratio": float64(1)},
+	}
+
+	var buf bytes.Buffer
+	assert.Nil(t, writeDatatableRowsCsv(&buf, rows, datatable))
+	assert.Equal(t, "key,name,enabled,count,ratio\nde,Berlin,true,12,1\nfr,\"Paris, France\",false,3,0.25\n", buf.String())
+
+	// The exported file is read back to the same rows
+	parsed, err := parseDatatableRowsCsv(buf.Bytes(), datatable)
+	assert.Nil(t, err)
+	assert.Equal(t, rows, parsed)
+}
+
+func TestUnitReconcileDatatableRows(t *testing.T) {
+	current := datatableRowsFromApi([]map[string]interface{}{
+		{"key": "same", "count": 1},
+		{"key": "changed", "count": 1},
+		{"key": "removed", "count": 1},
+	})
+	desired := datatableRows{
+		"same":    {"count": float64(1)},
+		"changed": {"count": float64(2)},
+		"added":   {"count": float64(1)},
+	}
+
+	changes := reconcileDatatableRows(current, desired)
+	assert.Equal(t, []string{"added"}, changes.create)
+	assert.Equal(t, []string{"changed"}, changes.update)
+	assert.Equal(t, []string{"removed"}, changes.delete)
+	assert.True(t, reconcileDatatableRows(desired, desired).isEmpty())
+}
+
+func TestUnitReconcileArchitectDatatableRows(t *testing.T) {
+	tableId := uuid.NewString()
+	importJobId := uuid.NewString()
+	datatable := testRowsDatatable(tableId)
+	var rowCalls []string
+	var importModes []string
+	var uploads []string
+	var rowReads int
+
+	originalPollInterval := datatableImportPollInterval
+	datatableImportPollInterval = time.Millisecond
+	originalMaxRowCalls := datatableRowsMaxRowCalls
+	defer func() {
+		datatableImportPollInterval = originalPollInterval
+		datatableRowsMaxRowCalls = originalMaxRowCalls
+	}()
+
+	rowProxy := &architectDatatableRowProxy{}
+	rowProxy.getArchitectDatatableAttr = func(ctx context.Context, p *architectDatatableRowProxy, id string, expanded string) (*Datatable, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tableId, id)
+		assert.Equal(t, "schema", expanded)
+		return datatable, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	rowProxy.getAllArchitectDatatableRowsAttr = func(ctx context.Context, p *architectDatatableRowProxy, id string) (*[]map[string]interface{}, *platformclientv2.APIResponse, error) {
+		rowReads++
+		return &[]map[string]interface{}{
+			{"key": "same", "name": "Same", "enabled": true, "count": 1, "ratio": 0},
+			{"key": "changed", "name": "Old", "enabled": true, "count": 1, "ratio": 0},
+			{"key": "removed", "name": "Removed", "enabled": true, "count": 1, "ratio": 0},
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	rowProxy.createArchitectDatatableRowAttr = func(ctx context.Context, p *architectDatatableRowProxy, id string, row *map[string]interface{}) (*map[string]interface{}, *platformclientv2.APIResponse, error) {
+		rowCalls = append(rowCalls, fmt.Sprintf("create %s %v", (*row)["key"], (*row)["name"]))
+		return row, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	rowProxy.updateArchitectDatatableRowAttr = func(ctx context.Context, p *architectDatatableRowProxy, id string, key string, row *map[string]interface{}) (*map[string]interface{}, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, key, (*row)["key"])
+		rowCalls = append(rowCalls, fmt.Sprintf("update %s %v", key, (*row)["name"]))
+		return row, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	rowProxy.deleteArchitectDatatableRowAttr = func(ctx context.Context, p *architectDatatableRowProxy, id string, key string) (*platformclientv2.APIResponse, error) {
+		rowCalls = append(rowCalls, "delete "+key)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	rowProxy.createArchitectDatatableImportJobAttr = func(ctx context.Context, p *architectDatatableRowProxy, id string, importMode string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, tableId, id)
+		importModes = append(importModes, importMode)
+		uploadUri := "https://upload/" + importJobId
+		return &platformclientv2.Datatableimportjob{Id: &importJobId, UploadURI: &uploadUri}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	rowProxy.uploadArchitectDatatableImportFileAttr = func(ctx context.Context, p *architectDatatableRowProxy, uploadUri string, rowsCsv []byte) ([]byte, error) {
+		assert.Equal(t, "https://upload/"+importJobId, uploadUri)
+		uploads = append(uploads, string(rowsCsv))
+		return nil, nil
+	}
+	jobPolls := 0
+	rowProxy.getArchitectDatatableImportJobAttr = func(ctx context.Context, p *architectDatatableRowProxy, id string, jobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, importJobId, jobId)
+		jobPolls++
+		status := "Processing"
+		if jobPolls > 1 {
+			status = "Succeeded"
+		}
+		return &platformclientv2.Datatableimportjob{Id: &jobId, Status: &status}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = rowProxy
+	defer func() {
+		internalProxy = nil
+	}()
+
+	rowsFile := filepath.Join(t.TempDir(), "rows.csv")
+	assert.Nil(t, os.WriteFile(rowsFile, []byte("key,name,count\nsame,Same,1\nchanged,New,1\nadded,Added,2\n"), 0644))
+
+	d := schema.TestResourceDataRaw(t, ResourceArchitectDatatableRows().Schema, map[string]interface{}{
+		"datatable_id": tableId,
+		"filepath":     rowsFile,
+	})
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	// A few changed rows are created, updated and deleted one by one
+	assert.Nil(t, reconcileArchitectDatatableRows(context.Background(), d, meta))
+	assert.Equal(t, []string{"create added Added", "update changed New", "delete removed"}, rowCalls)
+	assert.Empty(t, importModes)
+
+	// Rows that already match the file are left alone
+	rowCalls = nil
+	assert.Nil(t, os.WriteFile(rowsFile, []byte("key,name,count\nsame,Same,1\nchanged,Old,1\nremoved,Removed,1\n"), 0644))
+	assert.Nil(t, reconcileArchitectDatatableRows(context.Background(), d, meta))
+	assert.Empty(t, rowCalls)
+	assert.Equal(t, 2, rowReads)
+
+	// More changed rows are all imported in a single job replacing the rows of the datatable
+	datatableRowsMaxRowCalls = 2
+	assert.Nil(t, os.WriteFile(rowsFile, []byte("key,name,count\nsame,Same,1\nchanged,New,1\nadded,Added,2\n"), 0644))
+	assert.Nil(t, reconcileArchitectDatatableRows(context.Background(), d, meta))
+	assert.Empty(t, rowCalls)
+	assert.Equal(t, []string{"ReplaceAll"}, importModes)
+	assert.Equal(t, []string{"key,name,enabled,count,ratio\nadded,Added,true,2,0\nchanged,New,true,1,0\nsame,Same,true,1,0\n"}, uploads)
+	assert.Equal(t, 2, jobPolls)
+
+	// A failed import job is reported
+	rowProxy.getArchitectDatatableImportJobAttr = func(ctx context.Context, p *architectDatatableRowProxy, id string, jobId string) (*platformclientv2.Datatableimportjob, *platformclientv2.APIResponse, error) {
+		status := "Failed"
+		message := "invalid row"
+		return &platformclientv2.Datatableimportjob{Id: &jobId, Status: &status, ErrorInformation: &platformclientv2.Errorbody{Message: &message}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	diagErr := reconcileArchitectDatatableRows(context.Background(), d, meta)
+	assert.NotNil(t, diagErr)
+	assert.Contains(t, diagErr[0].Detail, "invalid row")
+}
+
+func TestUnitImportArchitectDatatableRows(t *testing.T) {
+	tableId := uuid.NewString()
+	rowsFile := filepath.Join(t.TempDir(), "rows,1.csv")
+	rowsContent := []byte("key,name\nfr,Paris\n")
+	assert.Nil(t, os.WriteFile(rowsFile, rowsContent, 0644))
+	resourceSchema := ResourceArchitectDatatableRows().Schema
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	d.SetId(rowsFile + "," + tableId)
+	imported, err := importArchitectDatatableRows(context.Background(), d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, tableId, imported[0].Id())
+	assert.Equal(t, rowsFile, imported[0].Get("filepath"))
+	assert.Equal(t, fmt.Sprintf("%x", sha256.Sum256(rowsContent)), imported[0].Get("file_content_hash"))
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	d.SetId(tableId)
+	imported, err = importArchitectDatatableRows(context.Background(), d, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, tableId, imported[0].Id())
+	assert.Equal(t, "", imported[0].Get("filepath"))
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	d.SetId(rowsFile + ",")
+	_, err = importArchitectDatatableRows(context.Background(), d, nil)
+	assert.NotNil(t, err)
+}
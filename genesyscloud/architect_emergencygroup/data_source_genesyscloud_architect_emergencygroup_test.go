@@ -2,8 +2,8 @@ package architect_emergencygroup
 
 import (
 	"fmt"
-	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/cassette"
 	"testing"
 
 	"github.com/google/uuid"
@@ -19,7 +19,7 @@ func TestAccDataSourceArchitectEmergencyGroup(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
-		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		ProviderFactories: cassette.ProviderFactories(t, providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				Config: GenerateArchitectEmergencyGroupResource(emergencyGroupResourceID,
@@ -7,6 +7,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/architect_flow"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/cassette"
 	"testing"
 
 	"github.com/google/uuid"
@@ -52,7 +53,7 @@ func TestAccResourceArchitectEmergencyGroups(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
-		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		ProviderFactories: cassette.ProviderFactories(t, providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				Config: flowResourceConfig + GenerateArchitectEmergencyGroupResource(
@@ -0,0 +1,78 @@
+package architect_flow
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// knownNameAttributes lists the attributes of the names managed in the configuration, with the attribute of the names
+// referenced by the flow and the kind of object they name
+var knownNameAttributes = []struct {
+	attribute      string
+	referencedAttr string
+	kind           string
+}{
+	{"known_queue_names", "referenced_queue_names", "queue"},
+	{"known_data_table_names", "referenced_data_table_names", "data table"},
+	{"known_prompt_names", "referenced_prompt_names", "prompt"},
+	{"known_flow_names", "referenced_flow_names", "flow"},
+}
+
+func dataSourceFlowValidateRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	filePath := d.Get("filepath").(string)
+	substitutions := d.Get("substitutions").(map[string]interface{})
+
+	log.Printf("Validating flow configuration %s", filePath)
+	flowConfig, err := readFlowConfiguration(filePath, substitutions)
+	if err != nil {
+		return util.BuildDiagnosticError(flowValidateDataSourceName, fmt.Sprintf("Failed to read flow configuration %s", filePath), err)
+	}
+
+	result := validateFlowConfiguration(flowConfig)
+	if len(result.errors) > 0 {
+		return util.BuildDiagnosticError(flowValidateDataSourceName, fmt.Sprintf("Flow configuration %s is invalid", filePath), fmt.Errorf("%s", strings.Join(result.errors, "\n")))
+	}
+
+	hash := sha256.Sum256([]byte(flowConfig))
+	d.SetId(hex.EncodeToString(hash[:]))
+	_ = d.Set("flow_type", result.flowType)
+	_ = d.Set("flow_name", result.flowName)
+
+	referenced := map[string][]string{
+		"referenced_queue_names":      result.references.queues,
+		"referenced_data_table_names": result.references.dataTables,
+		"referenced_prompt_names":     result.references.prompts,
+		"referenced_flow_names":       result.references.flows,
+	}
+
+	var diagErr diag.Diagnostics
+	var warnings []string
+	for _, names := range knownNameAttributes {
+		_ = d.Set(names.referencedAttr, referenced[names.referencedAttr])
+
+		// Only the kinds of objects listed in the configuration are checked
+		knownNames, ok := d.GetOk(names.attribute)
+		if !ok {
+			continue
+		}
+		known := lists.SetToStringList(knownNames.(*schema.Set))
+		for _, name := range unknownReferences(referenced[names.referencedAttr], *known) {
+			warning := fmt.Sprintf("Flow %s references %s %s, which is not in %s", result.flowName, names.kind, name, names.attribute)
+			warnings = append(warnings, warning)
+			diagErr = append(diagErr, diag.Diagnostic{Severity: diag.Warning, Summary: warning})
+		}
+	}
+	_ = d.Set("warnings", warnings)
+
+	log.Printf("Validated flow configuration %s", filePath)
+	return diagErr
+}
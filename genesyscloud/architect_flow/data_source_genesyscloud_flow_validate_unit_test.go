@@ -0,0 +1,129 @@
+package architect_flow
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const testFlowValidateConfig = `inboundCall:
+  name: "{{flow_name}}"
+  defaultLanguage: en-us
+  startUpRef: ./menus/menu[mainMenu]
+  initialGreeting:
+    exp: AudioPlaybackOptions(ToAudio(Prompt.welcome_prompt), true)
+  menus:
+    - menu:
+        name: Main Menu
+        audio:
+          exp: ToAudio(Prompt.main_menu)
+        refId: mainMenu
+        choices:
+          - menuTransferToAcd:
+              name: Support
+              dtmf: digit_1
+              targetQueue:
+                lit:
+                  name: "{{queue_name}}"
+          - menuTask:
+              name: Lookup
+              dtmf: digit_2
+              task:
+                actions:
+                  - findQueue:
+                      name: Find Queue
+                      findName:
+                        lit: Sales
+                      findResult:
+                        var: Task.queue
+                  - dataTableLookup:
+                      name: Data Table Lookup
+                      lookupValue:
+                        lit: "1"
+                      dataTable:
+                        Holidays:
+                          failureOutputs:
+                            errorType:
+                              noValue: true
+                  - transferToFlow:
+                      name: Transfer to Flow
+                      targetFlow:
+                        name: Billing Flow
+                  - callCommonModule:
+                      name: Call Common Module
+                      commonModule:
+                        Shared Module:
+                          ver_latest:
+                            inputs: {}
+`
+
+func TestUnitDataSourceFlowValidate(t *testing.T) {
+	flowFilePath := filepath.Join(t.TempDir(), "flow.yaml")
+	assert.Nil(t, os.WriteFile(flowFilePath, []byte(testFlowValidateConfig), 0644))
+
+	d := schema.TestResourceDataRaw(t, DataSourceFlowValidate().Schema, map[string]interface{}{
+		"filepath":          flowFilePath,
+		"substitutions":     map[string]interface{}{"flow_name": "Main Flow", "queue_name": "Support Queue"},
+		"known_queue_names": []interface{}{"support queue"},
+		"known_flow_names":  []interface{}{"Billing Flow"},
+	})
+	diagErr := dataSourceFlowValidateRead(context.Background(), d, nil)
+	assert.False(t, diagErr.HasError())
+
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, "INBOUNDCALL", d.Get("flow_type"))
+	assert.Equal(t, "Main Flow", d.Get("flow_name"))
+	assert.Equal(t, []interface{}{"Support Queue"}, d.Get("referenced_queue_names"))
+	assert.Equal(t, []interface{}{"Holidays"}, d.Get("referenced_data_table_names"))
+	assert.Equal(t, []interface{}{"main_menu", "welcome_prompt"}, d.Get("referenced_prompt_names"))
+	assert.Equal(t, []interface{}{"Billing Flow", "Shared Module"}, d.Get("referenced_flow_names"))
+
+	// Only the flows are checked as the data tables and prompts are not listed
+	assert.Len(t, diagErr, 1)
+	assert.Equal(t, diag.Warning, diagErr[0].Severity)
+	assert.Equal(t, []interface{}{"Flow Main Flow references flow Shared Module, which is not in known_flow_names"}, d.Get("warnings"))
+}
+
+func TestUnitDataSourceFlowValidateErrors(t *testing.T) {
+	testCases := map[string]struct {
+		config         string
+		expectedErrors []string
+	}{
+		"unresolved substitution": {
+			config:         testFlowValidateConfig,
+			expectedErrors: []string{"line 2: substitution flow_name is not set", "line 19: substitution queue_name is not set"},
+		},
+		"unknown flow type": {
+			config:         "inboundFax:\n  name: Fax\n",
+			expectedErrors: []string{"unknown flow type inboundFax"},
+		},
+		"missing name": {
+			config:         "inQueueCall:\n  defaultLanguage: en-us\n",
+			expectedErrors: []string{"inQueueCall.name must be set to the name of the flow"},
+		},
+		"several flows": {
+			config:         "inboundCall:\n  name: A\ninboundEmail:\n  name: B\n",
+			expectedErrors: []string{"expected a single top level key naming the flow type, found 2"},
+		},
+		"missing startUpRef target": {
+			config:         "inboundCall:\n  name: A\n  startUpRef: ./menus/menu[mainMenu]\n  menus:\n    - menu:\n        refId: otherMenu\n",
+			expectedErrors: []string{"inboundCall.startUpRef references ./menus/menu[mainMenu] but no menu, task or state has refId mainMenu"},
+		},
+	}
+	for name, testCase := range testCases {
+		result := validateFlowConfiguration(testCase.config)
+		assert.Equal(t, testCase.expectedErrors, result.errors, name)
+	}
+
+	flowFilePath := filepath.Join(t.TempDir(), "flow.yaml")
+	assert.Nil(t, os.WriteFile(flowFilePath, []byte("inboundCall:\n  name: [\n"), 0644))
+	d := schema.TestResourceDataRaw(t, DataSourceFlowValidate().Schema, map[string]interface{}{"filepath": flowFilePath})
+	diagErr := dataSourceFlowValidateRead(context.Background(), d, nil)
+	assert.True(t, diagErr.HasError())
+	assert.Contains(t, diagErr[0].Detail, "invalid YAML")
+}
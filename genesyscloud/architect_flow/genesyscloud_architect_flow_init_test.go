@@ -32,6 +32,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 	defer r.datasourceMapMutex.Unlock()
 
 	providerDataSources[resourceName] = DataSourceArchitectFlow()
+	providerDataSources[flowValidateDataSourceName] = DataSourceFlowValidate()
 }
 
 // initTestResources initializes all test resources and data sources.
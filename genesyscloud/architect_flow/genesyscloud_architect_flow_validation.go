@@ -0,0 +1,251 @@
+package architect_flow
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"gopkg.in/yaml.v3"
+)
+
+/*
+This file contains the offline validation of Architect flow configuration files. The file is checked the way it is
+uploaded, once the substitutions are applied, so that broken flows are reported at plan time instead of after the
+publish job of the flow fails. The references to other objects are found by the forms Archy uses to name them: a
+literal name under a queue, data table or flow setting, and Prompt.<name> in expressions.
+*/
+
+// flowTypesByKey maps the top level keys of flow configuration files to the type of the flows
+var flowTypesByKey = map[string]string{
+	"bot":                 "BOT",
+	"commonmodule":        "COMMONMODULE",
+	"digitalbot":          "DIGITALBOT",
+	"inboundcall":         "INBOUNDCALL",
+	"inboundchat":         "INBOUNDCHAT",
+	"inboundemail":        "INBOUNDEMAIL",
+	"inboundshortmessage": "INBOUNDSHORTMESSAGE",
+	"inqueuecall":         "INQUEUECALL",
+	"inqueueemail":        "INQUEUEEMAIL",
+	"inqueueshortmessage": "INQUEUESHORTMESSAGE",
+	"outboundcall":        "OUTBOUNDCALL",
+	"securecall":          "SECURECALL",
+	"surveyinvite":        "SURVEYINVITE",
+	"voice":               "VOICE",
+	"voicemail":           "VOICEMAIL",
+	"workflow":            "WORKFLOW",
+	"workitem":            "WORKITEM",
+}
+
+var (
+	substitutionPlaceholderRegex = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+	promptReferenceRegex         = regexp.MustCompile(`\bPrompt\.([A-Za-z0-9_]+)`)
+	refIdReferenceRegex          = regexp.MustCompile(`\[([^\[\]]+)\]$`)
+)
+
+// flowReferences holds the names of the objects referenced by a flow, sorted and without duplicates
+type flowReferences struct {
+	queues     []string
+	dataTables []string
+	prompts    []string
+	flows      []string
+}
+
+// flowValidationResult is the outcome of the validation of a flow configuration file
+type flowValidationResult struct {
+	flowType   string
+	flowName   string
+	references flowReferences
+
+	// Problems that would make the publish of the flow fail
+	errors []string
+}
+
+// readFlowConfiguration reads a flow configuration file and applies the substitutions the same way as when it is uploaded
+func readFlowConfiguration(filePath string, substitutions map[string]interface{}) (string, error) {
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	if file != nil {
+		defer file.Close()
+	} else if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return files.SubstituteValues(string(contents), substitutions), nil
+}
+
+// validateFlowConfiguration checks the structure of a flow configuration file and extracts the objects it references
+func validateFlowConfiguration(flowConfig string) *flowValidationResult {
+	result := &flowValidationResult{}
+	result.errors = append(result.errors, findUnresolvedPlaceholders(flowConfig)...)
+
+	var root map[string]interface{}
+	if err := yaml.Unmarshal([]byte(flowConfig), &root); err != nil {
+		result.errors = append(result.errors, fmt.Sprintf("invalid YAML: %v", err))
+		return result
+	}
+	if len(root) != 1 {
+		result.errors = append(result.errors, fmt.Sprintf("expected a single top level key naming the flow type, found %d", len(root)))
+		return result
+	}
+
+	var flowKey string
+	var flowDefinition interface{}
+	for key, value := range root {
+		flowKey, flowDefinition = key, value
+	}
+	flowType, ok := flowTypesByKey[strings.ToLower(flowKey)]
+	if !ok {
+		result.errors = append(result.errors, fmt.Sprintf("unknown flow type %s", flowKey))
+		return result
+	}
+	result.flowType = flowType
+
+	definition, ok := flowDefinition.(map[string]interface{})
+	if !ok {
+		result.errors = append(result.errors, fmt.Sprintf("%s must be a mapping of the flow settings", flowKey))
+		return result
+	}
+	if name, ok := definition["name"].(string); ok && name != "" {
+		result.flowName = name
+	} else {
+		result.errors = append(result.errors, fmt.Sprintf("%s.name must be set to the name of the flow", flowKey))
+	}
+
+	refIds := make(map[string]bool)
+	references := make(map[string]map[string]bool)
+	walkFlowDefinition(definition, refIds, references)
+
+	if startUpRef, ok := definition["startUpRef"].(string); ok {
+		if match := refIdReferenceRegex.FindStringSubmatch(startUpRef); match != nil && !refIds[match[1]] {
+			result.errors = append(result.errors, fmt.Sprintf("%s.startUpRef references %s but no menu, task or state has refId %s", flowKey, startUpRef, match[1]))
+		}
+	}
+
+	result.references = flowReferences{
+		queues:     sortedNames(references["queue"]),
+		dataTables: sortedNames(references["dataTable"]),
+		prompts:    sortedNames(references["prompt"]),
+		flows:      sortedNames(references["flow"]),
+	}
+	return result
+}
+
+// findUnresolvedPlaceholders reports the {{ }} placeholders that are left once the substitutions are applied
+func findUnresolvedPlaceholders(flowConfig string) []string {
+	var problems []string
+	scanner := bufio.NewScanner(strings.NewReader(flowConfig))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(flowConfig)+1)
+	for line := 1; scanner.Scan(); line++ {
+		for _, match := range substitutionPlaceholderRegex.FindAllStringSubmatch(scanner.Text(), -1) {
+			problems = append(problems, fmt.Sprintf("line %d: substitution %s is not set", line, match[1]))
+		}
+	}
+	return problems
+}
+
+// walkFlowDefinition collects the refIds defined in a flow and the names of the objects it references
+func walkFlowDefinition(value interface{}, refIds map[string]bool, references map[string]map[string]bool) {
+	addReference := func(kind, name string) {
+		if name == "" {
+			return
+		}
+		if references[kind] == nil {
+			references[kind] = make(map[string]bool)
+		}
+		references[kind][name] = true
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			switch lowerKey := strings.ToLower(key); {
+			case lowerKey == "refid":
+				if refId, ok := child.(string); ok {
+					refIds[refId] = true
+				}
+			case strings.HasSuffix(lowerKey, "queue"):
+				// Actions such as findQueue also end with queue, their name setting is the name of the action
+				addReference("queue", literalName(child, false))
+			case lowerKey == "targetflow" || lowerKey == "flow":
+				addReference("flow", literalName(child, true))
+			case lowerKey == "commonmodule" || lowerKey == "datatable":
+				kind := "flow"
+				if lowerKey == "datatable" {
+					kind = "dataTable"
+				}
+				// Archy names the data table or common module with the key of its settings
+				if name := literalName(child, true); name != "" {
+					addReference(kind, name)
+				} else if settings, ok := child.(map[string]interface{}); ok {
+					for name := range settings {
+						addReference(kind, name)
+					}
+				}
+			}
+			walkFlowDefinition(child, refIds, references)
+		}
+	case []interface{}:
+		for _, child := range v {
+			walkFlowDefinition(child, refIds, references)
+		}
+	case string:
+		for _, match := range promptReferenceRegex.FindAllStringSubmatch(v, -1) {
+			addReference("prompt", match[1])
+		}
+	}
+}
+
+// literalName returns the name of an object set as a literal in the lit setting of a value, or in its name setting
+// when allowName is set
+func literalName(value interface{}, allowName bool) string {
+	settings, ok := value.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	switch lit := settings["lit"].(type) {
+	case string:
+		return lit
+	case map[string]interface{}:
+		if name, ok := lit["name"].(string); ok {
+			return name
+		}
+	}
+	if name, ok := settings["name"].(string); ok && allowName {
+		return name
+	}
+	return ""
+}
+
+// unknownReferences returns the names referenced by a flow that are not in known, compared ignoring case
+func unknownReferences(referenced []string, known []string) []string {
+	knownNames := make(map[string]bool)
+	for _, name := range known {
+		knownNames[strings.ToLower(name)] = true
+	}
+	var unknown []string
+	for _, name := range referenced {
+		if !knownNames[strings.ToLower(name)] {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+func sortedNames(names map[string]bool) []string {
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -2,20 +2,56 @@ package architect_flow
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 	"log"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 )
 
 var internalProxy *architectFlowProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[architectFlowProxy]()
+
 type getArchitectFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error)
 type forceUnlockFlowFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.APIResponse, error)
 type deleteArchitectFlowFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.APIResponse, error)
 type createArchitectFlowJobsFunc func(context.Context, *architectFlowProxy) (*platformclientv2.Registerarchitectjobresponse, *platformclientv2.APIResponse, error)
 type getArchitectFlowJobsFunc func(context.Context, *architectFlowProxy, string) (*platformclientv2.Architectjobstateresponse, *platformclientv2.APIResponse, error)
 type getAllArchitectFlowsFunc func(context.Context, *architectFlowProxy) (*[]platformclientv2.Flow, *platformclientv2.APIResponse, error)
+type createArchitectFlowExportJobFunc func(context.Context, *architectFlowProxy, string) (*flowExportJobResponse, *platformclientv2.APIResponse, error)
+type getArchitectFlowExportJobFunc func(context.Context, *architectFlowProxy, string) (*flowExportJobState, *platformclientv2.APIResponse, error)
+type getArchitectFlowVersionsFunc func(context.Context, *architectFlowProxy, string) (*[]platformclientv2.Flowversion, *platformclientv2.APIResponse, error)
+type publishArchitectFlowVersionFunc func(context.Context, *architectFlowProxy, string, string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error)
+
+// flowExportJob is the body of an Architect flow export job. The export job API is not part of the SDK yet.
+type flowExportJob struct {
+	Flows      []flowExportDetails `json:"flows"`
+	ExportType string              `json:"exportType"`
+}
+
+// flowExportTypeYaml exports flows in the YAML format that Archy and genesyscloud_flow deploy, instead of the
+// Architect format
+const flowExportTypeYaml = "Yaml"
+
+type flowExportDetails struct {
+	Flow platformclientv2.Addressableentityref `json:"flow"`
+}
+
+type flowExportJobResponse struct {
+	Id *string `json:"id,omitempty"`
+}
+
+type flowExportJobState struct {
+	Id          *string                                 `json:"id,omitempty"`
+	Status      *string                                 `json:"status,omitempty"`
+	Messages    *[]platformclientv2.Architectjobmessage `json:"messages,omitempty"`
+	DownloadUrl *string                                 `json:"downloadUrl,omitempty"`
+}
 
 type architectFlowProxy struct {
 	clientConfig *platformclientv2.Configuration
@@ -27,13 +63,17 @@ type architectFlowProxy struct {
 	deleteArchitectFlowAttr     deleteArchitectFlowFunc
 	createArchitectFlowJobsAttr createArchitectFlowJobsFunc
 	getArchitectFlowJobsAttr    getArchitectFlowJobsFunc
+	createFlowExportJobAttr     createArchitectFlowExportJobFunc
+	getFlowExportJobAttr        getArchitectFlowExportJobFunc
+	getFlowVersionsAttr         getArchitectFlowVersionsFunc
+	publishFlowVersionAttr      publishArchitectFlowVersionFunc
 
 	flowCache rc.CacheInterface[platformclientv2.Flow]
 }
 
 func newArchitectFlowProxy(clientConfig *platformclientv2.Configuration) *architectFlowProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	flowCache := rc.NewResourceCache[platformclientv2.Flow]()
+	flowCache := rc.NewClientResourceCache[platformclientv2.Flow](clientConfig)
 	return &architectFlowProxy{
 		clientConfig: clientConfig,
 		api:          api,
@@ -44,15 +84,19 @@ func newArchitectFlowProxy(clientConfig *platformclientv2.Configuration) *archit
 		deleteArchitectFlowAttr:     deleteArchitectFlowFn,
 		createArchitectFlowJobsAttr: createArchitectFlowJobsFn,
 		getArchitectFlowJobsAttr:    getArchitectFlowJobsFn,
+		createFlowExportJobAttr:     createArchitectFlowExportJobFn,
+		getFlowExportJobAttr:        getArchitectFlowExportJobFn,
+		getFlowVersionsAttr:         getArchitectFlowVersionsFn,
+		publishFlowVersionAttr:      publishArchitectFlowVersionFn,
 		flowCache:                   flowCache,
 	}
 }
 
 func getArchitectFlowProxy(clientConfig *platformclientv2.Configuration) *architectFlowProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectFlowProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newArchitectFlowProxy)
 }
 
 func (a *architectFlowProxy) GetFlow(ctx context.Context, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
@@ -75,6 +119,24 @@ func (a *architectFlowProxy) GetFlowsDeployJob(ctx context.Context, jobId string
 	return a.getArchitectFlowJobsAttr(ctx, a, jobId)
 }
 
+func (a *architectFlowProxy) CreateFlowExportJob(ctx context.Context, flowId string) (*flowExportJobResponse, *platformclientv2.APIResponse, error) {
+	return a.createFlowExportJobAttr(ctx, a, flowId)
+}
+
+func (a *architectFlowProxy) GetFlowExportJob(ctx context.Context, jobId string) (*flowExportJobState, *platformclientv2.APIResponse, error) {
+	return a.getFlowExportJobAttr(ctx, a, jobId)
+}
+
+// GetFlowVersions returns all the versions of a flow
+func (a *architectFlowProxy) GetFlowVersions(ctx context.Context, flowId string) (*[]platformclientv2.Flowversion, *platformclientv2.APIResponse, error) {
+	return a.getFlowVersionsAttr(ctx, a, flowId)
+}
+
+// PublishFlowVersion publishes an existing version of a flow. The publish is asynchronous.
+func (a *architectFlowProxy) PublishFlowVersion(ctx context.Context, flowId string, version string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error) {
+	return a.publishFlowVersionAttr(ctx, a, flowId, version)
+}
+
 func (a *architectFlowProxy) GetAllFlows(ctx context.Context) (*[]platformclientv2.Flow, *platformclientv2.APIResponse, error) {
 	return a.getAllArchitectFlowsAttr(ctx, a)
 }
@@ -87,6 +149,28 @@ func getArchitectFlowFn(_ context.Context, p *architectFlowProxy, id string) (*p
 	return p.api.GetFlow(id, false)
 }
 
+func getArchitectFlowVersionsFn(_ context.Context, p *architectFlowProxy, flowId string) (*[]platformclientv2.Flowversion, *platformclientv2.APIResponse, error) {
+	var versions []platformclientv2.Flowversion
+	const pageSize = 100
+	for pageNum := 1; ; pageNum++ {
+		flowVersions, resp, err := p.api.GetFlowVersions(flowId, pageNum, pageSize, false)
+		if err != nil {
+			return nil, resp, err
+		}
+		if flowVersions.Entities == nil || len(*flowVersions.Entities) == 0 {
+			return &versions, resp, nil
+		}
+		versions = append(versions, *flowVersions.Entities...)
+		if flowVersions.PageCount == nil || pageNum >= *flowVersions.PageCount {
+			return &versions, resp, nil
+		}
+	}
+}
+
+func publishArchitectFlowVersionFn(_ context.Context, p *architectFlowProxy, flowId string, version string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error) {
+	return p.api.PostFlowsActionsPublish(flowId, version)
+}
+
 func forceUnlockFlowFn(_ context.Context, p *architectFlowProxy, flowId string) (*platformclientv2.APIResponse, error) {
 	log.Printf("Attempting to perform an unlock on flow: %s", flowId)
 	_, resp, err := p.api.PostFlowsActionsUnlock(flowId)
@@ -105,7 +189,63 @@ func getArchitectFlowJobsFn(_ context.Context, p *architectFlowProxy, jobId stri
 	return p.api.GetFlowsJob(jobId, []string{"messages"})
 }
 
+func createArchitectFlowExportJobFn(_ context.Context, p *architectFlowProxy, flowId string) (*flowExportJobResponse, *platformclientv2.APIResponse, error) {
+	body := flowExportJob{
+		Flows:      []flowExportDetails{{Flow: platformclientv2.Addressableentityref{Id: &flowId}}},
+		ExportType: flowExportTypeYaml,
+	}
+
+	var successPayload *flowExportJobResponse
+	response, err := p.callFlowExportJobApi(http.MethodPost, p.clientConfig.BasePath+"/api/v2/flows/export/jobs", body, nil)
+	if err == nil {
+		err = json.Unmarshal(response.RawBody, &successPayload)
+	}
+	return successPayload, response, err
+}
+
+func getArchitectFlowExportJobFn(_ context.Context, p *architectFlowProxy, jobId string) (*flowExportJobState, *platformclientv2.APIResponse, error) {
+	queryParams := map[string]string{"expand": "messages"}
+
+	var successPayload *flowExportJobState
+	response, err := p.callFlowExportJobApi(http.MethodGet, p.clientConfig.BasePath+"/api/v2/flows/export/jobs/"+jobId, nil, queryParams)
+	if err == nil {
+		err = json.Unmarshal(response.RawBody, &successPayload)
+	}
+	return successPayload, response, err
+}
+
+// callFlowExportJobApi is the non-sdk helper method for the Architect flow export job endpoints
+func (p *architectFlowProxy) callFlowExportJobApi(method string, path string, body interface{}, queryParams map[string]string) (*platformclientv2.APIResponse, error) {
+	apiClient := &p.clientConfig.APIClient
+
+	headerParams := make(map[string]string)
+
+	// oauth required
+	if p.clientConfig.AccessToken != "" {
+		headerParams["Authorization"] = "Bearer " + p.clientConfig.AccessToken
+	}
+	// add default headers if any
+	for key := range p.clientConfig.DefaultHeader {
+		headerParams[key] = p.clientConfig.DefaultHeader[key]
+	}
+
+	headerParams["Content-Type"] = "application/json"
+	headerParams["Accept"] = "application/json"
+
+	response, err := apiClient.CallAPI(path, method, body, headerParams, queryParams, nil, "", nil)
+	if err != nil {
+		// Nothing special to do here, but do avoid processing the response
+	} else if response.Error != nil {
+		err = errors.New(response.ErrorMessage)
+	}
+	return response, err
+}
+
 func getAllArchitectFlowsFn(ctx context.Context, p *architectFlowProxy) (*[]platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.flowCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	const pageSize = 100
 	var totalFlows []platformclientv2.Flow
 
@@ -133,6 +273,7 @@ func getAllArchitectFlowsFn(ctx context.Context, p *architectFlowProxy) (*[]plat
 	for _, flow := range totalFlows {
 		rc.SetCache(p.flowCache, *flow.Id, flow)
 	}
+	rc.SetCacheComplete(p.flowCache)
 
 	return &totalFlows, nil, nil
 }
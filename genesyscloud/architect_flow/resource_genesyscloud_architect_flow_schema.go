@@ -2,6 +2,7 @@ package architect_flow
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"terraform-provider-genesyscloud/genesyscloud/validators"
 
 	"terraform-provider-genesyscloud/genesyscloud/provider"
@@ -10,12 +11,14 @@ import (
 )
 
 const (
-	resourceName = "genesyscloud_flow"
+	resourceName               = "genesyscloud_flow"
+	flowValidateDataSourceName = "genesyscloud_flow_validate"
 )
 
 // SetRegistrar registers all resources, data sources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(resourceName, DataSourceArchitectFlow())
+	l.RegisterDataSource(flowValidateDataSourceName, DataSourceFlowValidate())
 	l.RegisterResource(resourceName, ResourceArchitectFlow())
 	l.RegisterExporter(resourceName, ArchitectFlowExporter())
 }
@@ -27,9 +30,16 @@ func ArchitectFlowExporter() *resourceExporter.ResourceExporter {
 		UnResolvableAttributes: map[string]*schema.Schema{
 			"filepath": ResourceArchitectFlow().Schema["filepath"],
 		},
+		// The exported flow file is the published version of the flow
+		ExcludedAttributes: []string{"published_version", "published_version_pinned", "latest_version", "versions"},
 		CustomFlowResolver: map[string]*resourceExporter.CustomFlowResolver{
 			"file_content_hash": {ResolverFunc: resourceExporter.FileContentHashResolver},
 		},
+		// The filepath variable above is only kept for flows whose definition could not be exported
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: ArchitectFlowResolver,
+			SubDirectory:              "flows",
+		},
 	}
 }
 
@@ -45,6 +55,7 @@ func ResourceArchitectFlow() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeFlowVersionDiff,
 		Schema: map[string]*schema.Schema{
 			"filepath": {
 				Description:  "YAML file path for flow configuration. Note: Changing the flow name will result in the creation of a new flow with a new GUID, while the original flow will persist in your org.",
@@ -68,6 +79,34 @@ func ResourceArchitectFlow() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"published_version": {
+				Description: "Version of the flow that is published, e.g. 3.0. Set it to an existing version to republish that version instead of uploading the flow file, for example to roll back the flow. Changes to the flow file are not published while it is set. Once it is removed, the latest version of the flow is published again.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"latest_version": {
+				Description: "Latest version of the flow checked in to Architect, such as the version published from the flow file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"published_version_pinned": {
+				Description: "Whether published_version was set in the configuration at the last apply. Once published_version is removed, the latest version of the flow is published again.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"version_history_count": {
+				Description:  "Number of the latest versions of the flow listed in versions. The versions are not read when it is 0, the default. This does not delete any version: Architect has no API to delete the versions of a flow, so the older versions are kept in the org and only left out of versions.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"versions": {
+				Description: "Ids of the latest versions of the flow, latest first, that published_version can be set to. Limited to version_history_count versions.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -85,3 +124,88 @@ func DataSourceArchitectFlow() *schema.Resource {
 		},
 	}
 }
+
+func DataSourceFlowValidate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Validates a flow configuration file without calling Genesys Cloud. The file is checked once the substitutions are applied: the flow type, the flow name, the startUpRef and the substitution placeholders left in the file. The queues, data tables, prompts and flows referenced by name are listed, and a warning is reported for each of them that is not in the matching known names attribute when it is set.",
+		ReadContext: dataSourceFlowValidateRead,
+		Schema: map[string]*schema.Schema{
+			"filepath": {
+				Description:  "YAML file path for flow configuration.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"substitutions": {
+				Description: "A substitution is a key value pair where the key is the value you want to replace, and the value is the value to substitute in its place.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"known_queue_names": {
+				Description: "Names of the queues managed in the configuration. When set, queues referenced by the flow that are not in the list are reported.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"known_data_table_names": {
+				Description: "Names of the data tables managed in the configuration. When set, data tables referenced by the flow that are not in the list are reported.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"known_prompt_names": {
+				Description: "Names of the user prompts managed in the configuration. When set, prompts referenced by the flow that are not in the list are reported.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"known_flow_names": {
+				Description: "Names of the flows and common modules managed in the configuration. When set, flows referenced by the flow that are not in the list are reported.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"flow_type": {
+				Description: "Type of the flow, e.g. INBOUNDCALL.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"flow_name": {
+				Description: "Name of the flow.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"referenced_queue_names": {
+				Description: "Names of the queues referenced by the flow.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"referenced_data_table_names": {
+				Description: "Names of the data tables referenced by the flow.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"referenced_prompt_names": {
+				Description: "Names of the user prompts referenced by the flow.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"referenced_flow_names": {
+				Description: "Names of the flows and common modules referenced by the flow.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"warnings": {
+				Description: "References to objects that are not in the known names.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
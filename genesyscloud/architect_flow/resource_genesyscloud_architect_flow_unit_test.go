@@ -0,0 +1,282 @@
+package architect_flow
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+const testFlowYaml = `inboundCall:
+  name: Test Flow
+  startUpRef: "/inboundCall/menus/menu[Main Menu]"
+`
+
+func mockFlowExportProxy(t *testing.T, flowId string, downloadUrl string) {
+	jobId := uuid.NewString()
+	polls := 0
+
+	flowProxy := &architectFlowProxy{}
+	flowProxy.createFlowExportJobAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*flowExportJobResponse, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, flowId, id)
+		return &flowExportJobResponse{Id: &jobId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	flowProxy.getFlowExportJobAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*flowExportJobState, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, jobId, id)
+		polls++
+		// The job is still running the first time its status is checked
+		status := "Started"
+		if polls > 1 {
+			status = "Success"
+			return &flowExportJobState{Id: &jobId, Status: &status, DownloadUrl: &downloadUrl}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		}
+		return &flowExportJobState{Id: &jobId, Status: &status}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = flowProxy
+	flowExportJobPollInterval = time.Millisecond
+	t.Cleanup(func() {
+		internalProxy = nil
+		flowExportJobPollInterval = 3 * time.Second
+	})
+}
+
+func TestUnitArchitectFlowResolver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testFlowYaml))
+	}))
+	defer server.Close()
+
+	flowId := uuid.NewString()
+	mockFlowExportProxy(t, flowId, server.URL)
+
+	exportDir := t.TempDir()
+	configMap := map[string]interface{}{
+		"filepath":          "${var.genesyscloud_flow_test_filepath}",
+		"file_content_hash": "${filesha256(var.genesyscloud_flow_test_filepath)}",
+	}
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	assert.Nil(t, ArchitectFlowResolver(flowId, exportDir, "flows", configMap, meta))
+
+	contents, err := os.ReadFile(filepath.Join(exportDir, "flows", "flow-"+flowId+".yaml"))
+	assert.Nil(t, err)
+	assert.Equal(t, testFlowYaml, string(contents))
+	assert.Equal(t, "flows/flow-"+flowId+".yaml", configMap["filepath"])
+	assert.Equal(t, `${filesha256("flows/flow-`+flowId+`.yaml")}`, configMap["file_content_hash"])
+}
+
+func TestUnitArchitectFlowResolverZipArchive(t *testing.T) {
+	var archive bytes.Buffer
+	zipWriter := zip.NewWriter(&archive)
+	w, _ := zipWriter.Create("Test Flow.yaml")
+	_, _ = w.Write([]byte(testFlowYaml))
+	assert.Nil(t, zipWriter.Close())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive.Bytes())
+	}))
+	defer server.Close()
+
+	flowId := uuid.NewString()
+	mockFlowExportProxy(t, flowId, server.URL)
+
+	exportDir := t.TempDir()
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	assert.Nil(t, ArchitectFlowResolver(flowId, exportDir, "flows", map[string]interface{}{}, meta))
+
+	contents, err := os.ReadFile(filepath.Join(exportDir, "flows", "flow-"+flowId+".yaml"))
+	assert.Nil(t, err)
+	assert.Equal(t, testFlowYaml, string(contents))
+}
+
+func TestUnitArchitectFlowResolverJobFailure(t *testing.T) {
+	flowId := uuid.NewString()
+	jobId := uuid.NewString()
+	status := "Failure"
+	message := "Flow is not published"
+
+	flowProxy := &architectFlowProxy{}
+	flowProxy.createFlowExportJobAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*flowExportJobResponse, *platformclientv2.APIResponse, error) {
+		return &flowExportJobResponse{Id: &jobId}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	flowProxy.getFlowExportJobAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*flowExportJobState, *platformclientv2.APIResponse, error) {
+		return &flowExportJobState{Id: &jobId, Status: &status, Messages: &[]platformclientv2.Architectjobmessage{{Text: &message}}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = flowProxy
+	defer func() { internalProxy = nil }()
+
+	configMap := map[string]interface{}{"filepath": "${var.genesyscloud_flow_test_filepath}"}
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	err := ArchitectFlowResolver(flowId, t.TempDir(), "flows", configMap, meta)
+	assert.ErrorContains(t, err, message)
+	assert.Equal(t, "${var.genesyscloud_flow_test_filepath}", configMap["filepath"], "filepath is left as a variable when the flow could not be exported")
+}
+
+func TestUnitArchitectFlowResolverNotYaml(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<html><body>Access denied</body></html>"))
+	}))
+	defer server.Close()
+
+	flowId := uuid.NewString()
+	mockFlowExportProxy(t, flowId, server.URL)
+
+	exportDir := t.TempDir()
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	assert.ErrorContains(t, ArchitectFlowResolver(flowId, exportDir, "flows", map[string]interface{}{}, meta), "is not a YAML flow configuration")
+	_, err := os.Stat(filepath.Join(exportDir, "flows", "flow-"+flowId+".yaml"))
+	assert.True(t, os.IsNotExist(err), "expected the download not to be written as the flow")
+}
+
+func TestUnitArchitectFlowResolverJobWithoutId(t *testing.T) {
+	flowProxy := &architectFlowProxy{}
+	flowProxy.createFlowExportJobAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*flowExportJobResponse, *platformclientv2.APIResponse, error) {
+		return &flowExportJobResponse{}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = flowProxy
+	defer func() { internalProxy = nil }()
+
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	assert.ErrorContains(t, ArchitectFlowResolver(uuid.NewString(), t.TempDir(), "flows", map[string]interface{}{}, meta), "registered without an id")
+}
+
+func TestUnitCreateArchitectFlowExportJob(t *testing.T) {
+	var body flowExportJob
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/flows/export/jobs", r.URL.Path)
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		_, _ = w.Write([]byte(`{"id": "job-id"}`))
+	}))
+	defer server.Close()
+
+	config := platformclientv2.GetDefaultConfiguration()
+	basePath := config.BasePath
+	config.BasePath = server.URL
+	defer func() { config.BasePath = basePath }()
+
+	job, _, err := createArchitectFlowExportJobFn(context.Background(), &architectFlowProxy{clientConfig: config}, "flow-id")
+	assert.Nil(t, err)
+	assert.Equal(t, "job-id", *job.Id)
+	assert.Equal(t, flowExportTypeYaml, body.ExportType)
+	assert.Equal(t, "flow-id", *body.Flows[0].Flow.Id)
+}
+
+// mockFlowVersionsProxy mocks a flow with the versions 1.0 to 10.0, version 10.0 being published. It returns the
+// versions published and the number of times the versions were listed.
+func mockFlowVersionsProxy(t *testing.T, flowId string) (*[]string, *int) {
+	publishedVersion := "10.0"
+	checkedInVersion := "10.0"
+	var publishedVersions []string
+	versionListings := 0
+
+	flowProxy := &architectFlowProxy{}
+	flowProxy.getArchitectFlowAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*platformclientv2.Flow, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, flowId, id)
+		name := "Test Flow"
+		return &platformclientv2.Flow{Id: &flowId, Name: &name, PublishedVersion: &platformclientv2.Flowversion{Id: &publishedVersion}, CheckedInVersion: &platformclientv2.Flowversion{Id: &checkedInVersion}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	flowProxy.getFlowVersionsAttr = func(ctx context.Context, p *architectFlowProxy, id string) (*[]platformclientv2.Flowversion, *platformclientv2.APIResponse, error) {
+		versionListings++
+		var versions []platformclientv2.Flowversion
+		for i := 1; i <= 10; i++ {
+			versionId := fmt.Sprintf("%d.0", i)
+			versions = append(versions, platformclientv2.Flowversion{Id: &versionId})
+		}
+		return &versions, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	flowProxy.publishFlowVersionAttr = func(ctx context.Context, p *architectFlowProxy, id string, version string) (*platformclientv2.Operation, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, flowId, id)
+		publishedVersions = append(publishedVersions, version)
+		publishedVersion = version
+		return &platformclientv2.Operation{}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	internalProxy = flowProxy
+	flowPublishPollInterval = time.Millisecond
+	t.Cleanup(func() {
+		internalProxy = nil
+		flowPublishPollInterval = 5 * time.Second
+	})
+	return &publishedVersions, &versionListings
+}
+
+func TestUnitPublishFlowVersion(t *testing.T) {
+	flowId := uuid.NewString()
+	publishedVersions, _ := mockFlowVersionsProxy(t, flowId)
+	p := getArchitectFlowProxy(nil)
+
+	diagErr := publishFlowVersion(context.Background(), p, flowId, "9.0")
+	assert.False(t, diagErr.HasError())
+	assert.Equal(t, []string{"9.0"}, *publishedVersions)
+
+	// The version is not published again once it is the published version
+	diagErr = publishFlowVersion(context.Background(), p, flowId, "9.0")
+	assert.False(t, diagErr.HasError())
+	assert.Equal(t, []string{"9.0"}, *publishedVersions)
+
+	diagErr = publishFlowVersion(context.Background(), p, flowId, "11.0")
+	assert.True(t, diagErr.HasError())
+	assert.Contains(t, diagErr[0].Detail, "version 11.0 does not exist, the versions of the flow are 10.0, 9.0")
+	assert.Equal(t, []string{"9.0"}, *publishedVersions)
+}
+
+func TestUnitReadFlowVersions(t *testing.T) {
+	flowId := uuid.NewString()
+	_, versionListings := mockFlowVersionsProxy(t, flowId)
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	// The versions are not listed by default
+	d := schema.TestResourceDataRaw(t, ResourceArchitectFlow().Schema, map[string]interface{}{
+		"filepath":          "flow.yaml",
+		"file_content_hash": "hash",
+	})
+	d.SetId(flowId)
+	diagErr := readFlow(context.Background(), d, meta)
+	assert.False(t, diagErr.HasError())
+	assert.Equal(t, "10.0", d.Get("published_version"))
+	assert.Equal(t, "10.0", d.Get("latest_version"))
+	assert.Equal(t, []interface{}{}, d.Get("versions"))
+	assert.Equal(t, 0, *versionListings)
+
+	d = schema.TestResourceDataRaw(t, ResourceArchitectFlow().Schema, map[string]interface{}{
+		"filepath":              "flow.yaml",
+		"file_content_hash":     "hash",
+		"version_history_count": 3,
+	})
+	d.SetId(flowId)
+	diagErr = readFlow(context.Background(), d, meta)
+	assert.False(t, diagErr.HasError())
+	assert.Equal(t, []interface{}{"10.0", "9.0", "8.0"}, d.Get("versions"))
+	assert.Equal(t, 1, *versionListings)
+}
+
+func TestUnitSortedFlowVersionIds(t *testing.T) {
+	var versions []platformclientv2.Flowversion
+	for _, id := range []string{"2.0", "10.0", "1.0", "9.1", "9.0"} {
+		versionId := id
+		versions = append(versions, platformclientv2.Flowversion{Id: &versionId})
+	}
+	assert.Equal(t, []string{"10.0", "9.1", "9.0", "2.0", "1.0"}, sortedFlowVersionIds(versions))
+}
+
+func TestUnitFlowExportVersion(t *testing.T) {
+	published, checkedIn := "1.0", "2.0"
+	flow := platformclientv2.Flow{PublishedVersion: &platformclientv2.Flowversion{Id: &published}}
+	assert.Equal(t, "1.0/", flowExportVersion(flow))
+
+	flow.CheckedInVersion = &platformclientv2.Flowversion{Id: &checkedIn}
+	assert.Equal(t, "1.0/2.0", flowExportVersion(flow), "expected a new checked in version to change the export version")
+}
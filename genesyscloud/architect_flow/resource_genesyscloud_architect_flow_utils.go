@@ -1,14 +1,39 @@
 package architect_flow
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"io"
 	"log"
+	"net/http"
+	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"gopkg.in/yaml.v3"
 )
 
+// flowExportJobPollInterval is the time to wait between checks of the status of a flow export job
+var flowExportJobPollInterval = 3 * time.Second
+
+// flowExportDownloadTimeout is the time allowed to download the result of a flow export job
+const flowExportDownloadTimeout = 5 * time.Minute
+
 func isForceUnlockEnabled(d *schema.ResourceData) bool {
 	forceUnlock := d.Get("force_unlock").(bool)
 	log.Printf("ForceUnlock: %v, id %v", forceUnlock, d.Id())
@@ -42,3 +67,249 @@ func GenerateFlowResource(resourceID, srcFile, fileContent string, forceUnlock b
 func setFileContentHashToNil(d *schema.ResourceData) {
 	_ = d.Set("file_content_hash", nil)
 }
+
+// ArchitectFlowResolver exports the YAML definition of a flow with an Architect export job and writes it to the
+// sub directory of the export, so that the exported genesyscloud_flow can be deployed again as is
+func ArchitectFlowResolver(flowId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}) error {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	p := getArchitectFlowProxy(sdkConfig)
+	ctx := context.Background()
+
+	exportFileName := fmt.Sprintf("flow-%s.yaml", flowId)
+
+	fullPath := path.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	job, resp, err := p.CreateFlowExportJob(ctx, flowId)
+	if err != nil {
+		return fmt.Errorf("failed to register export job for flow %s: %v %v", flowId, err, resp)
+	}
+	if job == nil || job.Id == nil {
+		return fmt.Errorf("export job for flow %s was registered without an id %v", flowId, resp)
+	}
+	jobId := *job.Id
+
+	downloadUrl := ""
+	retryErr := util.WithRetries(ctx, 5*time.Minute, func() *retry.RetryError {
+		jobState, resp, err := p.GetFlowExportJob(ctx, jobId)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Error retrieving export job status. JobID: %s, error: %s ", jobId, err), resp))
+		}
+
+		if jobState.Status != nil && *jobState.Status == "Failure" {
+			messages := make([]string, 0)
+			if jobState.Messages != nil {
+				for _, m := range *jobState.Messages {
+					if m.Text != nil {
+						messages = append(messages, *m.Text)
+					}
+				}
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("flow export failed. JobID: %s, tracing messages: %v ", jobId, strings.Join(messages, "\n\n")), resp))
+		}
+
+		if jobState.Status != nil && *jobState.Status == "Success" && jobState.DownloadUrl != nil {
+			downloadUrl = *jobState.DownloadUrl
+			return nil
+		}
+
+		time.Sleep(flowExportJobPollInterval)
+		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Export job (%s) could not finish in 5 minutes and timed out ", jobId), resp))
+	})
+	if retryErr != nil {
+		return fmt.Errorf("failed to export flow %s: %v", flowId, retryErr)
+	}
+
+	flowYaml, err := downloadFlowExport(ctx, provider.NewHTTPClient(sdkConfig, flowExportDownloadTimeout), downloadUrl)
+	if err != nil {
+		return fmt.Errorf("failed to download export of flow %s: %v", flowId, err)
+	}
+	// Error pages of the download server are not written as the flow
+	var flowConfig map[string]interface{}
+	if err := yaml.Unmarshal(flowYaml, &flowConfig); err != nil {
+		return fmt.Errorf("export of flow %s is not a YAML flow configuration: %v", flowId, err)
+	}
+	if len(flowConfig) == 0 {
+		return fmt.Errorf("export of flow %s is empty", flowId)
+	}
+	if err := os.WriteFile(path.Join(fullPath, exportFileName), flowYaml, 0644); err != nil {
+		return err
+	}
+
+	// Update filepath field in configMap to point to exported flow file
+	configMap["filepath"] = path.Join(subDirectory, exportFileName)
+	configMap["file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, path.Join(subDirectory, exportFileName))
+
+	return nil
+}
+
+// downloadFlowExport downloads the result of a flow export job. Depending on the flow, the result is either the YAML
+// file itself or a zip archive containing it.
+func downloadFlowExport(ctx context.Context, client *http.Client, downloadUrl string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(content, []byte("PK\x03\x04")) {
+		return content, nil
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range archive.File {
+		if ext := strings.ToLower(path.Ext(file.Name)); ext != ".yaml" && ext != ".yml" {
+			continue
+		}
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return io.ReadAll(reader)
+	}
+	return nil, fmt.Errorf("no YAML file found in the exported archive")
+}
+
+// flowPublishPollInterval is the time to wait between checks of the published version of a flow after a publish
+var flowPublishPollInterval = 5 * time.Second
+
+// flowFileAttributes are the attributes that publish a new version of the flow when they change
+var flowFileAttributes = []string{"filepath", "file_content_hash", "substitutions"}
+
+// getPinnedPublishedVersion returns the version set in published_version in the configuration. It is empty when the
+// attribute is not set, in which case published_version is only read from the flow.
+func getPinnedPublishedVersion(rawConfig cty.Value) string {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return ""
+	}
+	version := rawConfig.GetAttr("published_version")
+	if version.IsNull() || !version.IsKnown() {
+		return ""
+	}
+	return version.AsString()
+}
+
+// customizeFlowVersionDiff plans the versions of the flow. A change of the flow file publishes a new version, and
+// removing published_version from the configuration publishes the latest version of the flow again. Versions
+// published outside of Terraform are left as they are.
+func customizeFlowVersionDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if getPinnedPublishedVersion(d.GetRawConfig()) != "" {
+		return d.SetNew("published_version_pinned", true)
+	}
+	if d.HasChanges(flowFileAttributes...) {
+		for _, attr := range []string{"published_version", "latest_version", "versions"} {
+			if err := d.SetNewComputed(attr); err != nil {
+				return err
+			}
+		}
+	}
+	if d.GetRawConfig().IsNull() || !d.Get("published_version_pinned").(bool) {
+		// Without the configuration, an unset published_version cannot be told apart from a pinned one
+		return nil
+	}
+
+	if err := d.SetNew("published_version_pinned", false); err != nil {
+		return err
+	}
+	if d.HasChanges(flowFileAttributes...) {
+		return nil
+	}
+	publishedVersion, latestVersion := d.Get("published_version").(string), d.Get("latest_version").(string)
+	if latestVersion != "" && publishedVersion != latestVersion {
+		return d.SetNew("published_version", latestVersion)
+	}
+	return nil
+}
+
+// publishFlowVersion publishes an existing version of a flow and waits for it to become the published version
+func publishFlowVersion(ctx context.Context, p *architectFlowProxy, flowId string, version string) diag.Diagnostics {
+	flow, resp, err := p.GetFlow(ctx, flowId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to read flow %s: %s", flowId, err), resp)
+	}
+	if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil && *flow.PublishedVersion.Id == version {
+		log.Printf("Version %s of flow %s is already published", version, flowId)
+		return nil
+	}
+
+	versions, resp, err := p.GetFlowVersions(ctx, flowId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get the versions of flow %s: %s", flowId, err), resp)
+	}
+	versionIds := sortedFlowVersionIds(*versions)
+	if !lists.ItemInSlice(version, versionIds) {
+		return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Failed to publish version %s of flow %s", version, flowId), fmt.Errorf("version %s does not exist, the versions of the flow are %s", version, strings.Join(versionIds, ", ")))
+	}
+
+	log.Printf("Publishing version %s of flow %s", version, flowId)
+	if _, resp, err := p.PublishFlowVersion(ctx, flowId, version); err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to publish version %s of flow %s: %s", version, flowId, err), resp)
+	}
+
+	return util.WithRetries(ctx, 5*time.Minute, func() *retry.RetryError {
+		flow, resp, err := p.GetFlow(ctx, flowId)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to read flow %s: %s", flowId, err), resp))
+		}
+		if operation := flow.CurrentOperation; operation != nil && operation.ErrorMessage != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Failed to publish version %s of flow %s: %s", version, flowId, *operation.ErrorMessage), resp))
+		}
+		if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil && *flow.PublishedVersion.Id == version {
+			log.Printf("Published version %s of flow %s", version, flowId)
+			return nil
+		}
+
+		time.Sleep(flowPublishPollInterval)
+		return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("Version %s of flow %s was not published within 5 minutes", version, flowId), resp))
+	})
+}
+
+// sortedFlowVersionIds returns the ids of the versions of a flow, latest first
+func sortedFlowVersionIds(versions []platformclientv2.Flowversion) []string {
+	ids := make([]string, 0, len(versions))
+	for _, version := range versions {
+		if version.Id != nil {
+			ids = append(ids, *version.Id)
+		}
+	}
+	sort.SliceStable(ids, func(i, j int) bool {
+		return compareFlowVersionIds(ids[i], ids[j]) > 0
+	})
+	return ids
+}
+
+// compareFlowVersionIds compares version ids such as 2.0 and 10.0 by their numbers
+func compareFlowVersionIds(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aNum, aErr := strconv.Atoi(aParts[i])
+		bNum, bErr := strconv.Atoi(bParts[i])
+		if aErr != nil || bErr != nil {
+			return strings.Compare(a, b)
+		}
+		if aNum != bNum {
+			return aNum - bNum
+		}
+	}
+	return len(aParts) - len(bParts)
+}
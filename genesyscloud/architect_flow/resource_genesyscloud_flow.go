@@ -37,17 +37,30 @@ func getAllFlows(ctx context.Context, clientConfig *platformclientv2.Configurati
 		overrideBCPNaming := os.Getenv("OVERRIDE_BCP_NAMING")
 
 		if overrideBCPNaming != "" {
-			resources[*flow.Id] = &resourceExporter.ResourceMeta{Name: *flow.Name}
+			resources[*flow.Id] = &resourceExporter.ResourceMeta{Name: *flow.Name, Version: flowExportVersion(flow)}
 			continue
 		}
 
 		//This is our go forward naming standard for flows.
-		resources[*flow.Id] = &resourceExporter.ResourceMeta{Name: *flow.VarType + "_" + *flow.Name}
+		resources[*flow.Id] = &resourceExporter.ResourceMeta{Name: *flow.VarType + "_" + *flow.Name, Version: flowExportVersion(flow)}
 	}
 
 	return resources, nil
 }
 
+// flowExportVersion identifies the published and checked in versions of a flow, which is all readFlow reads
+// besides the name
+func flowExportVersion(flow platformclientv2.Flow) string {
+	var publishedVersion, checkedInVersion string
+	if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+		publishedVersion = *flow.PublishedVersion.Id
+	}
+	if flow.CheckedInVersion != nil && flow.CheckedInVersion.Id != nil {
+		checkedInVersion = *flow.CheckedInVersion.Id
+	}
+	return publishedVersion + "/" + checkedInVersion
+}
+
 func readFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 
@@ -62,6 +75,31 @@ func readFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("failed to read flow %s: %s", d.Id(), err), resp))
 		}
 
+		publishedVersion := ""
+		if flow.PublishedVersion != nil && flow.PublishedVersion.Id != nil {
+			publishedVersion = *flow.PublishedVersion.Id
+		}
+		latestVersion := publishedVersion
+		if flow.CheckedInVersion != nil && flow.CheckedInVersion.Id != nil {
+			latestVersion = *flow.CheckedInVersion.Id
+		}
+		_ = d.Set("published_version", publishedVersion)
+		_ = d.Set("latest_version", latestVersion)
+
+		// The versions are only listed when they are requested
+		versionIds := make([]string, 0)
+		if historyCount := d.Get("version_history_count").(int); historyCount > 0 {
+			versions, resp, err := proxy.GetFlowVersions(ctx, d.Id())
+			if err != nil {
+				return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(resourceName, fmt.Sprintf("failed to read the versions of flow %s: %s", d.Id(), err), resp))
+			}
+			versionIds = sortedFlowVersionIds(*versions)
+			if historyCount < len(versionIds) {
+				versionIds = versionIds[:historyCount]
+			}
+		}
+		_ = d.Set("versions", versionIds)
+
 		log.Printf("Read flow %s %s", d.Id(), *flow.Name)
 		return nil
 	})
@@ -78,6 +116,25 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	log.Printf("Updating flow")
 
+	pinnedVersion := getPinnedPublishedVersion(d.GetRawConfig())
+	_ = d.Set("published_version_pinned", pinnedVersion != "")
+
+	if !d.IsNewResource() {
+		// The flow file is only uploaded when it changes, other updates republish an existing version of the flow
+		if pinnedVersion != "" {
+			return publishPinnedFlowVersion(ctx, d, meta, p, pinnedVersion)
+		}
+		if !d.HasChanges(append(flowFileAttributes, "force_unlock")...) {
+			if d.HasChange("published_version") {
+				if diagErr := publishFlowVersion(ctx, p, d.Id(), d.Get("published_version").(string)); diagErr != nil {
+					return diagErr
+				}
+			}
+			log.Printf("Updated flow %s", d.Id())
+			return readFlow(ctx, d, meta)
+		}
+	}
+
 	//Check to see if we need to force and unlock on an architect flow
 	if isForceUnlockEnabled(d) {
 		resp, err := p.ForceUnlockFlow(ctx, d.Id())
@@ -162,10 +219,45 @@ func updateFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	d.SetId(flowID)
 
+	// A flow that already existed can be pinned to one of its earlier versions as soon as it is created
+	if pinnedVersion != "" {
+		if diagErr := publishFlowVersion(ctx, p, d.Id(), pinnedVersion); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	log.Printf("Updated flow %s. ", d.Id())
 	return readFlow(ctx, d, meta)
 }
 
+// publishPinnedFlowVersion publishes the version of the flow set in published_version. Changes to the flow file are not
+// uploaded while a version is pinned, they stay pending until published_version is removed.
+func publishPinnedFlowVersion(ctx context.Context, d *schema.ResourceData, meta interface{}, p *architectFlowProxy, version string) diag.Diagnostics {
+	var diagErr diag.Diagnostics
+	if d.HasChanges(flowFileAttributes...) {
+		setFileContentHashToNil(d)
+		diagErr = append(diagErr, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Changes to the file of flow %s were not published", d.Id()),
+			Detail:   fmt.Sprintf("published_version pins the flow to version %s. Remove published_version to publish the flow file.", version),
+		})
+	}
+
+	if isForceUnlockEnabled(d) {
+		resp, err := p.ForceUnlockFlow(ctx, d.Id())
+		if err != nil {
+			return append(diagErr, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to unlock targeted flow %s with error %s", d.Id(), err), resp)...)
+		}
+	}
+
+	if publishErr := publishFlowVersion(ctx, p, d.Id(), version); publishErr != nil {
+		return append(diagErr, publishErr...)
+	}
+
+	log.Printf("Updated flow %s to version %s", d.Id(), version)
+	return append(diagErr, readFlow(ctx, d, meta)...)
+}
+
 func deleteFlow(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	p := getArchitectFlowProxy(sdkConfig)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
@@ -18,6 +19,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *architectGrammarProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[architectGrammarProxy]()
+
 // Type definitions for each func on our proxy so that we can easily mock them out later
 type createArchitectGrammarFunc func(ctx context.Context, p *architectGrammarProxy, grammar *platformclientv2.Grammar) (*platformclientv2.Grammar, *platformclientv2.APIResponse, error)
 type getAllArchitectGrammarFunc func(ctx context.Context, p *architectGrammarProxy) (*[]platformclientv2.Grammar, *platformclientv2.APIResponse, error)
@@ -42,7 +46,7 @@ type architectGrammarProxy struct {
 // newArchitectGrammarProxy initializes the grammar proxy with all the data needed to communicate with Genesys Cloud
 func newArchitectGrammarProxy(clientConfig *platformclientv2.Configuration) *architectGrammarProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	grammarCache := rc.NewResourceCache[platformclientv2.Grammar]()
+	grammarCache := rc.NewClientResourceCache[platformclientv2.Grammar](clientConfig)
 	return &architectGrammarProxy{
 		clientConfig:                    clientConfig,
 		architectApi:                    api,
@@ -56,14 +60,13 @@ func newArchitectGrammarProxy(clientConfig *platformclientv2.Configuration) *arc
 	}
 }
 
-// getArchitectGrammarProxy acts as a singleton for the internalProxy. It also ensures
+// getArchitectGrammarProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getArchitectGrammarProxy(clientConfig *platformclientv2.Configuration) *architectGrammarProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectGrammarProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newArchitectGrammarProxy)
 }
 
 // createArchitectGrammar creates a Genesys Cloud Architect Grammar
@@ -107,6 +110,10 @@ func createArchitectGrammarFn(_ context.Context, p *architectGrammarProxy, gramm
 
 // getAllArchitectGrammarFn is the implementation for retrieving all Architect Grammars in Genesys Cloud
 func getAllArchitectGrammarFn(_ context.Context, p *architectGrammarProxy) (*[]platformclientv2.Grammar, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.grammarCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	var allGrammars []platformclientv2.Grammar
 
 	grammars, resp, err := p.architectApi.GetArchitectGrammars(1, 100, "", "", []string{}, "", "", "", true)
@@ -136,6 +143,7 @@ func getAllArchitectGrammarFn(_ context.Context, p *architectGrammarProxy) (*[]p
 	for _, grammar := range allGrammars {
 		rc.SetCache(p.grammarCache, *grammar.Id, grammar)
 	}
+	rc.SetCacheComplete(p.grammarCache)
 
 	return &allGrammars, resp, nil
 }
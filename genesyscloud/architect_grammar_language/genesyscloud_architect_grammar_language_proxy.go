@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util/files"
 	"time"
@@ -27,6 +28,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *architectGrammarLanguageProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[architectGrammarLanguageProxy]()
+
 // Type definitions for each func on our proxy so that we can easily mock them out later
 type createArchitectGrammarLanguageFunc func(ctx context.Context, p *architectGrammarLanguageProxy, language *platformclientv2.Grammarlanguage) (*platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error)
 type getArchitectGrammarLanguageByIdFunc func(ctx context.Context, p *architectGrammarLanguageProxy, grammarId string, languageCode string) (*platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error)
@@ -49,7 +53,7 @@ type architectGrammarLanguageProxy struct {
 // newArchitectGrammarLanguageProxy initializes the grammar Language proxy with all the data needed to communicate with Genesys Cloud
 func newArchitectGrammarLanguageProxy(clientConfig *platformclientv2.Configuration) *architectGrammarLanguageProxy {
 	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
-	grammarLanguageCache := rc.NewResourceCache[platformclientv2.Grammarlanguage]()
+	grammarLanguageCache := rc.NewClientResourceCache[platformclientv2.Grammarlanguage](clientConfig)
 	return &architectGrammarLanguageProxy{
 		clientConfig:                        clientConfig,
 		architectApi:                        api,
@@ -62,14 +66,13 @@ func newArchitectGrammarLanguageProxy(clientConfig *platformclientv2.Configurati
 	}
 }
 
-// getArchitectGrammarLanguageProxy acts as a singleton for the internalProxy. It also ensures
+// getArchitectGrammarLanguageProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getArchitectGrammarLanguageProxy(clientConfig *platformclientv2.Configuration) *architectGrammarLanguageProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectGrammarLanguageProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newArchitectGrammarLanguageProxy)
 }
 
 // createArchitectGrammarLanguage creates a Genesys Cloud Architect Grammar Language
@@ -203,6 +206,10 @@ func uploadGrammarLanguageFile(p *architectGrammarLanguageProxy, language *platf
 
 // getAllArchitectGrammarLanguageFn is the implementation for retrieving all Architect Grammars in Genesys Cloud
 func getAllArchitectGrammarLanguageFn(_ context.Context, p *architectGrammarLanguageProxy) (*[]platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.grammarLanguageCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	var allLanguages []platformclientv2.Grammarlanguage
 
 	grammars, resp, err := p.architectApi.GetArchitectGrammars(1, 100, "", "", []string{}, "", "", "", true)
@@ -241,6 +248,7 @@ func getAllArchitectGrammarLanguageFn(_ context.Context, p *architectGrammarLang
 	for _, language := range allLanguages {
 		rc.SetCache(p.grammarLanguageCache, fmt.Sprintf("%s:%s", *language.GrammarId, *language.Language), language)
 	}
+	rc.SetCacheComplete(p.grammarLanguageCache)
 
 	return &allLanguages, resp, nil
 }
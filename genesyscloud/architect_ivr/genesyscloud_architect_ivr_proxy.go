@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	utillists "terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"time"
 
@@ -33,6 +34,9 @@ Each proxy implementation:
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *architectIvrProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[architectIvrProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createArchitectIvrFunc func(context.Context, *architectIvrProxy, platformclientv2.Ivr) (*platformclientv2.Ivr, *platformclientv2.APIResponse, error)
 type getArchitectIvrFunc func(context.Context, *architectIvrProxy, string) (*platformclientv2.Ivr, *platformclientv2.APIResponse, error)
@@ -40,11 +44,13 @@ type updateArchitectIvrFunc func(context.Context, *architectIvrProxy, string, pl
 type deleteArchitectIvrFunc func(context.Context, *architectIvrProxy, string) (*platformclientv2.APIResponse, error)
 type getAllArchitectIvrsFunc func(context.Context, *architectIvrProxy, string) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error)
 type getArchitectIvrIdByNameFunc func(context.Context, *architectIvrProxy, string) (id string, retryable bool, response *platformclientv2.APIResponse, err error)
+type getAllDidNumbersFunc func(context.Context, *architectIvrProxy) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error)
 
 // architectIvrProxy contains all methods that call genesys cloud APIs.
 type architectIvrProxy struct {
 	clientConfig *platformclientv2.Configuration
 	api          *platformclientv2.ArchitectApi
+	telephonyApi *platformclientv2.TelephonyProvidersEdgeApi
 
 	createArchitectIvrAttr      createArchitectIvrFunc
 	getArchitectIvrAttr         getArchitectIvrFunc
@@ -52,6 +58,7 @@ type architectIvrProxy struct {
 	deleteArchitectIvrAttr      deleteArchitectIvrFunc
 	getAllArchitectIvrsAttr     getAllArchitectIvrsFunc
 	getArchitectIvrIdByNameAttr getArchitectIvrIdByNameFunc
+	getAllDidNumbersAttr        getAllDidNumbersFunc
 
 	maxDnisPerRequest int
 
@@ -66,6 +73,7 @@ func newArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *archite
 	return &architectIvrProxy{
 		clientConfig: clientConfig,
 		api:          api,
+		telephonyApi: platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig),
 
 		createArchitectIvrAttr:      createArchitectIvrFn,
 		getArchitectIvrAttr:         getArchitectIvrFn,
@@ -73,6 +81,7 @@ func newArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *archite
 		deleteArchitectIvrAttr:      deleteArchitectIvrFn,
 		getAllArchitectIvrsAttr:     getAllArchitectIvrsFn,
 		getArchitectIvrIdByNameAttr: getArchitectIvrIdByNameFn,
+		getAllDidNumbersAttr:        getAllDidNumbersFn,
 
 		maxDnisPerRequest: maxDnisPerRequest,
 
@@ -81,13 +90,13 @@ func newArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *archite
 	}
 }
 
-// getArchitectIvrProxy acts as a singleton to for the internalProxy.  It also ensures
+// getArchitectIvrProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getArchitectIvrProxy(clientConfig *platformclientv2.Configuration) *architectIvrProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectIvrProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newArchitectIvrProxy)
 }
 
 // getAllArchitectIvrs retrieves all Genesys Cloud Architect IVRs
@@ -214,6 +223,31 @@ func getArchitectIvrIdByNameFn(ctx context.Context, a *architectIvrProxy, name s
 	return "", true, resp, fmt.Errorf("failed to find ivr with name '%s': %v", name, err)
 }
 
+// getAllDidNumbers retrieves all the numbers of the DID pools of the org, with the entity they are assigned to
+func (a *architectIvrProxy) getAllDidNumbers(ctx context.Context) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+	return a.getAllDidNumbersAttr(ctx, a)
+}
+
+// getAllDidNumbersFn is an implementation function for retrieving all the numbers of the DID pools of the org
+func getAllDidNumbersFn(_ context.Context, a *architectIvrProxy) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+	var allNumbers []platformclientv2.Didnumber
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		numbers, resp, err := a.telephonyApi.GetTelephonyProvidersEdgesDidpoolsDids("ASSIGNED_AND_UNASSIGNED", nil, "", pageSize, pageNum, "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("error requesting page of DID pool numbers: %v", err)
+		}
+		if numbers.Entities == nil || len(*numbers.Entities) == 0 {
+			return &allNumbers, resp, nil
+		}
+		allNumbers = append(allNumbers, *numbers.Entities...)
+		if numbers.PageCount == nil || pageNum >= *numbers.PageCount {
+			return &allNumbers, resp, nil
+		}
+	}
+}
+
 // uploadArchitectIvrWithChunkingLogic creates/updates an IVR. The function breaks the dnis field into chunks and uploads them in subsequent
 // PUTs if the dnis array length is greater than a.maxDnisPerRequest
 func (a *architectIvrProxy) uploadArchitectIvrWithChunkingLogic(ctx context.Context, post bool, id string, ivr platformclientv2.Ivr) (*platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
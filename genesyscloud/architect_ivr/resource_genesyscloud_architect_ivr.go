@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
@@ -33,6 +34,9 @@ func getAllIvrConfigs(ctx context.Context, clientConfig *platformclientv2.Config
 
 	for _, entity := range *allIvrs {
 		resources[*entity.Id] = &resourceExporter.ResourceMeta{Name: *entity.Name}
+		if entity.Version != nil {
+			resources[*entity.Id].Version = strconv.Itoa(*entity.Version)
+		}
 	}
 	return resources, nil
 }
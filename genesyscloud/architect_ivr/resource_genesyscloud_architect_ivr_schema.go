@@ -48,6 +48,7 @@ func ResourceArchitectIvrConfig() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: validateDnisAssignments,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "Name of the IVR config. Note: If the name changes, the existing Genesys Cloud IVR config will be dropped and recreated with a new ID. This can cause an Architect Flow to become invalid if the old flow is reference in the flow.",
@@ -67,6 +68,11 @@ func ResourceArchitectIvrConfig() *schema.Resource {
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validators.ValidatePhoneNumber},
 			},
+			"validate_dnis_assignments": {
+				Description: "Check at plan time that the numbers added to dnis are in a DID pool and are not assigned to another IVR, a user or a phone. Leave it unset when the DID pools of the numbers are created in the same apply as the IVR, as they do not exist yet at plan time.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 			"open_hours_flow_id": {
 				Description: "ID of inbound call flow for open hours.",
 				Type:        schema.TypeString,
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 	"github.com/stretchr/testify/assert"
 )
@@ -352,3 +353,56 @@ func buildIvrResourceMap(tId string, tName string, tDescription string, tIDnis [
 	}
 	return resourceDataMap
 }
+
+func TestUnitResourceArchitectIvrValidateDnisAssignments(t *testing.T) {
+	tId := uuid.NewString()
+	otherIvrId := uuid.NewString()
+	otherIvrName := "Other IVR"
+	userId := uuid.NewString()
+	didPoolId := uuid.NewString()
+
+	didNumbers := []platformclientv2.Didnumber{
+		{Number: platformclientv2.String("+1 317-555-0100"), Assigned: platformclientv2.Bool(false), DidPool: &platformclientv2.Addressableentityref{Id: &didPoolId}},
+		{Number: platformclientv2.String("+13175550101"), Assigned: platformclientv2.Bool(true), DidPool: &platformclientv2.Addressableentityref{Id: &didPoolId},
+			Owner: &platformclientv2.Domainentityref{Id: &otherIvrId, Name: &otherIvrName}, OwnerType: platformclientv2.String("IVR_CONFIG")},
+		{Number: platformclientv2.String("+13175550102"), Assigned: platformclientv2.Bool(true), DidPool: &platformclientv2.Addressableentityref{Id: &didPoolId},
+			Owner: &platformclientv2.Domainentityref{Id: &userId}, OwnerType: platformclientv2.String("USER")},
+		{Number: platformclientv2.String("+13175550103"), Assigned: platformclientv2.Bool(true), DidPool: &platformclientv2.Addressableentityref{Id: &didPoolId},
+			Owner: &platformclientv2.Domainentityref{Id: &tId}, OwnerType: platformclientv2.String("IVR_CONFIG")},
+	}
+	listings := 0
+	archProxy := &architectIvrProxy{}
+	archProxy.getAllDidNumbersAttr = func(ctx context.Context, a *architectIvrProxy) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+		listings++
+		return &didNumbers, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = archProxy
+	defer func() { internalProxy = nil }()
+
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	resource := ResourceArchitectIvrConfig()
+	state := &terraform.InstanceState{ID: tId, Attributes: map[string]string{"name": "IVR"}}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                      "IVR",
+		"dnis":                      []interface{}{"+13175550100", "+13175550101", "+13175550102", "+13175550103", "+13175550199"},
+		"validate_dnis_assignments": true,
+	})
+	_, err := resource.SimpleDiff(context.Background(), state, config, gcloud)
+	assert.ErrorContains(t, err, fmt.Sprintf("+13175550101 is already assigned to IVR_CONFIG Other IVR (%s)", otherIvrId))
+	assert.ErrorContains(t, err, fmt.Sprintf("+13175550102 is already assigned to USER %s", userId))
+	assert.ErrorContains(t, err, "+13175550199 is outside every DID pool")
+	assert.NotContains(t, err.Error(), "+13175550100")
+	assert.NotContains(t, err.Error(), "+13175550103")
+	assert.Equal(t, 1, listings, "the DID pool numbers are listed once for all the DNIS")
+
+	// The numbers are not checked unless validate_dnis_assignments is set
+	listings = 0
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "IVR",
+		"dnis": []interface{}{"+13175550101"},
+	})
+	_, err = resource.SimpleDiff(context.Background(), state, config, gcloud)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, listings)
+}
@@ -1,9 +1,11 @@
 package architect_ivr
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 
@@ -83,3 +85,78 @@ func buildArchitectIvrFromResourceData(d *schema.ResourceData) *platformclientv2
 
 	return &ivrBody
 }
+
+// validateDnisAssignments fails the plan when a DNIS added to the IVR is outside every DID pool of the org, or is
+// already assigned to another IVR, a user or a phone. Only runs when validate_dnis_assignments is set, since DID pools
+// created in the same apply as the IVR do not exist yet at plan time.
+func validateDnisAssignments(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("validate_dnis_assignments").(bool) || !d.NewValueKnown("dnis") || !d.HasChange("dnis") {
+		return nil
+	}
+
+	oldDnis, newDnis := d.GetChange("dnis")
+	existing := make(map[string]bool)
+	for _, number := range oldDnis.(*schema.Set).List() {
+		existing[util.SanitizeE164Number(number.(string))] = true
+	}
+
+	var addedDnis []string
+	for _, number := range *lists.SetToStringList(newDnis.(*schema.Set)) {
+		if dnis := util.SanitizeE164Number(number); !existing[dnis] {
+			addedDnis = append(addedDnis, dnis)
+		}
+	}
+	if len(addedDnis) == 0 {
+		return nil
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	ap := getArchitectIvrProxy(sdkConfig)
+
+	// The numbers are listed once and indexed, rather than looked up one DNIS at a time
+	allDidNumbers, _, err := ap.getAllDidNumbers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check the DNIS of the IVR: %v", err)
+	}
+	didNumbers := make(map[string]platformclientv2.Didnumber)
+	for _, didNumber := range *allDidNumbers {
+		if didNumber.Number != nil {
+			didNumbers[util.SanitizeE164Number(*didNumber.Number)] = didNumber
+		}
+	}
+
+	var problems []string
+	for _, dnis := range addedDnis {
+		if problem := checkDnisAssignment(d.Id(), dnis, didNumbers); problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid dnis:\n%s", strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// checkDnisAssignment describes why a DNIS cannot be assigned to the IVR, empty when it can be. didNumbers holds the
+// numbers of the DID pools by their sanitized number.
+func checkDnisAssignment(ivrId string, dnis string, didNumbers map[string]platformclientv2.Didnumber) string {
+	didNumber, ok := didNumbers[dnis]
+	if !ok {
+		return fmt.Sprintf("%s is outside every DID pool", dnis)
+	}
+	if didNumber.Assigned == nil || !*didNumber.Assigned || didNumber.Owner == nil || didNumber.Owner.Id == nil {
+		return ""
+	}
+	ownerType := "entity"
+	if didNumber.OwnerType != nil {
+		ownerType = *didNumber.OwnerType
+	}
+	if ownerType == "IVR_CONFIG" && *didNumber.Owner.Id == ivrId {
+		return ""
+	}
+	owner := *didNumber.Owner.Id
+	if didNumber.Owner.Name != nil {
+		owner = fmt.Sprintf("%s (%s)", *didNumber.Owner.Name, *didNumber.Owner.Id)
+	}
+	return fmt.Sprintf("%s is already assigned to %s %s", dnis, ownerType, owner)
+}
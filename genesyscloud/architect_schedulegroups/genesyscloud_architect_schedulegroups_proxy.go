@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -16,6 +17,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *architectSchedulegroupsProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[architectSchedulegroupsProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createArchitectSchedulegroupsFunc func(ctx context.Context, p *architectSchedulegroupsProxy, scheduleGroup *platformclientv2.Schedulegroup) (*platformclientv2.Schedulegroup, *platformclientv2.APIResponse, error)
 type getAllArchitectSchedulegroupsFunc func(ctx context.Context, p *architectSchedulegroupsProxy) (*[]platformclientv2.Schedulegroup, *platformclientv2.APIResponse, error)
@@ -51,13 +55,13 @@ func newArchitectSchedulegroupsProxy(clientConfig *platformclientv2.Configuratio
 	}
 }
 
-// getArchitectSchedulegroupsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getArchitectSchedulegroupsProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getArchitectSchedulegroupsProxy(clientConfig *platformclientv2.Configuration) *architectSchedulegroupsProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectSchedulegroupsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newArchitectSchedulegroupsProxy)
 }
 
 // createArchitectSchedulegroups creates a Genesys Cloud architect schedulegroups
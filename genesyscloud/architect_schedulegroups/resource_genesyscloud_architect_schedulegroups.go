@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
@@ -34,6 +35,9 @@ func getAllAuthArchitectSchedulegroups(ctx context.Context, clientConfig *platfo
 
 	for _, scheduleGroup := range *scheduleGroups {
 		resources[*scheduleGroup.Id] = &resourceExporter.ResourceMeta{Name: *scheduleGroup.Name}
+		if scheduleGroup.Version != nil {
+			resources[*scheduleGroup.Id].Version = strconv.Itoa(*scheduleGroup.Version)
+		}
 	}
 
 	return resources, nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
@@ -21,6 +22,9 @@ simulate these smaller parts, known as stubs, to ensure that each function behav
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *architectSchedulesProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[architectSchedulesProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createArchitectSchedulesFunc func(ctx context.Context, p *architectSchedulesProxy, schedules *platformclientv2.Schedule) (*platformclientv2.Schedule, *platformclientv2.APIResponse, error)
 type getAllArchitectSchedulesFunc func(ctx context.Context, p *architectSchedulesProxy) (*[]platformclientv2.Schedule, *platformclientv2.APIResponse, error)
@@ -55,8 +59,8 @@ This includes configuring the proxy with the required data and settings so that
 seamlessly with the Genesys Cloud platform.
 */
 func newArchitectSchedulesProxy(clientConfig *platformclientv2.Configuration) *architectSchedulesProxy {
-	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)    // NewArchitectApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
-	schedulesCache := rc.NewResourceCache[platformclientv2.Schedule]() // Create Cache for architect schedules resource
+	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)                      // NewArchitectApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
+	schedulesCache := rc.NewClientResourceCache[platformclientv2.Schedule](clientConfig) // Create Cache for architect schedules resource
 	return &architectSchedulesProxy{
 		clientConfig:                      clientConfig,
 		architectApi:                      api,
@@ -78,10 +82,10 @@ This ensures consistency and control in managing the internalProxy across our co
 facilitating efficient testing by providing a straightforward way to substitute the proxy for testing purposes.
 */
 func getArchitectSchedulesProxy(clientConfig *platformclientv2.Configuration) *architectSchedulesProxy {
-	if internalProxy == nil {
-		internalProxy = newArchitectSchedulesProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newArchitectSchedulesProxy)
 }
 
 // createArchitectSchedules creates a Genesys Cloud architect schedules
@@ -128,6 +132,10 @@ func createArchitectSchedulesFn(ctx context.Context, p *architectSchedulesProxy,
 
 // getAllArchitectSchedulesFn is the implementation for retrieving all architect schedules in Genesys Cloud
 func getAllArchitectSchedulesFn(ctx context.Context, p *architectSchedulesProxy) (*[]platformclientv2.Schedule, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.schedulesCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	var allSchedules []platformclientv2.Schedule
 	const pageSize = 100
 
@@ -159,6 +167,7 @@ func getAllArchitectSchedulesFn(ctx context.Context, p *architectSchedulesProxy)
 	for _, schedule := range allSchedules {
 		rc.SetCache(p.schedulesCache, *schedule.Id, schedule)
 	}
+	rc.SetCacheComplete(p.schedulesCache)
 
 	return &allSchedules, apiResponse, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -35,6 +36,9 @@ func getAllArchitectSchedules(ctx context.Context, clientConfig *platformclientv
 
 	for _, schedule := range *schedules {
 		resources[*schedule.Id] = &resourceExporter.ResourceMeta{Name: *schedule.Name}
+		if schedule.Version != nil {
+			resources[*schedule.Id].Version = strconv.Itoa(*schedule.Version)
+		}
 	}
 
 	return resources, nil
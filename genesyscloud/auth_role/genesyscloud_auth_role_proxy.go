@@ -3,6 +3,7 @@ package auth_role
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
@@ -18,6 +19,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *authRoleProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[authRoleProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createAuthRoleFunc func(ctx context.Context, p *authRoleProxy, domainOrganizationRole *platformclientv2.Domainorganizationrolecreate) (*platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error)
 type getAllAuthRoleFunc func(ctx context.Context, p *authRoleProxy) (*[]platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error)
@@ -48,7 +52,7 @@ type authRoleProxy struct {
 // newAuthRoleProxy initializes the auth role proxy with all of the data needed to communicate with Genesys Cloud
 func newAuthRoleProxy(clientConfig *platformclientv2.Configuration) *authRoleProxy {
 	api := platformclientv2.NewAuthorizationApiWithConfig(clientConfig)
-	authRoleCache := rc.NewResourceCache[platformclientv2.Domainorganizationrole]() // Create Cache for authRole resource
+	authRoleCache := rc.NewClientResourceCache[platformclientv2.Domainorganizationrole](clientConfig) // Create Cache for authRole resource
 	return &authRoleProxy{
 		clientConfig:              clientConfig,
 		authorizationApi:          api,
@@ -65,13 +69,13 @@ func newAuthRoleProxy(clientConfig *platformclientv2.Configuration) *authRolePro
 	}
 }
 
-// getAuthRoleProxy acts as a singleton to for the internalProxy.  It also ensures
+// getAuthRoleProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getAuthRoleProxy(clientConfig *platformclientv2.Configuration) *authRoleProxy {
-	if internalProxy == nil {
-		internalProxy = newAuthRoleProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newAuthRoleProxy)
 }
 
 // createAuthRole creates a Genesys Cloud auth role
@@ -135,6 +139,10 @@ func createAuthRoleFn(ctx context.Context, p *authRoleProxy, authRole *platformc
 
 // getAllAuthRoleFn is the implementation for retrieving all auth role in Genesys Cloud
 func getAllAuthRoleFn(ctx context.Context, p *authRoleProxy) (*[]platformclientv2.Domainorganizationrole, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.authRoleCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	const pageSize = 100
 	var allAuthRoles []platformclientv2.Domainorganizationrole
 
@@ -164,6 +172,7 @@ func getAllAuthRoleFn(ctx context.Context, p *authRoleProxy) (*[]platformclientv
 	for _, authRole := range allAuthRoles {
 		rc.SetCache(p.authRoleCache, *authRole.Id, authRole)
 	}
+	rc.SetCacheComplete(p.authRoleCache)
 
 	return &allAuthRoles, resp, nil
 }
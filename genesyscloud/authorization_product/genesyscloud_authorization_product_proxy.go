@@ -3,6 +3,7 @@ package authorization_product
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -16,6 +17,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *authProductProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[authProductProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAuthorizationProductFunc func(ctx context.Context, p *authProductProxy, name string) (id string, retryable bool, response *platformclientv2.APIResponse, err error)
 
@@ -36,13 +40,13 @@ func newauthProductProxy(clientConfig *platformclientv2.Configuration) *authProd
 	}
 }
 
-// getauthProductProxy acts as a singleton to for the internalProxy.  It also ensures
+// getauthProductProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getauthProductProxy(clientConfig *platformclientv2.Configuration) *authProductProxy {
-	if internalProxy == nil {
-		internalProxy = newauthProductProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newauthProductProxy)
 }
 
 // getAuthorizationProduct returns a single Genesys Cloud authorization product by a name
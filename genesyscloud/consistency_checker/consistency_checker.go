@@ -2,10 +2,8 @@ package consistency_checker
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
-	"os"
 	"reflect"
 	"strconv"
 	"strings"
@@ -48,10 +46,14 @@ type consistencyError struct {
 }
 
 type consistencyErrorJson struct {
-	ResourceType     string `json:"resourceType"`
-	ResourceId       string `json:"resourceId"`
-	GCloudObjectName string `json:"GCloudObjectName"`
-	ErrorMessage     string `json:"errorMessage"`
+	ResourceType     string      `json:"resourceType"`
+	ResourceId       string      `json:"resourceId"`
+	GCloudObjectName string      `json:"GCloudObjectName"`
+	Attribute        string      `json:"attribute"`
+	ExpectedValue    interface{} `json:"expectedValue"`
+	ActualValue      interface{} `json:"actualValue"`
+	Checks           int         `json:"checks"`
+	ErrorMessage     string      `json:"errorMessage"`
 }
 
 func (e *consistencyError) Error() string {
@@ -215,6 +217,15 @@ func (c *ConsistencyCheck) isComputed(d *schema.ResourceData, key string) bool {
 	return resourceSchema[k].Computed
 }
 
+// expectedValue returns the original value of an attribute. Nested attributes are not in the original state, so the
+// value from the diff is used for them instead.
+func (c *ConsistencyCheck) expectedValue(key string, diffValue string) interface{} {
+	if value, ok := c.originalState[key]; ok {
+		return value
+	}
+	return diffValue
+}
+
 func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.RetryError {
 	if c.isEmptyState == nil {
 		panic("consistencyCheck must be initialized with NewConsistencyCheck")
@@ -225,7 +236,7 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 	}
 
 	if featureToggles.CCToggleExists() {
-		log.Printf("%s is set, write consistency errors to consistency-errors.log.json", featureToggles.CCToggleName())
+		log.Printf("%s is set, consistency errors will be written to %s", featureToggles.CCToggleName(), consistencyReportPath())
 	} else {
 		log.Printf("%s is not set, consistency checker behaving as default", featureToggles.CCToggleName())
 	}
@@ -244,6 +255,9 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 			if strings.HasSuffix(k, "#") {
 				continue
 			}
+			if c.isConsistentByRule(currentState, k) {
+				continue
+			}
 			vTemp := v.Old
 			v.Old = v.New
 			v.New = vTemp
@@ -264,12 +278,12 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 					if !compareValues(c.originalState[parts[0]], vv, slice1Index, slice2Index, key) {
 						err := retry.RetryableError(&consistencyError{
 							key:      k,
-							oldValue: c.originalState[k],
+							oldValue: c.expectedValue(k, v.Old),
 							newValue: currentState.Get(k),
 						})
 
 						if exists := featureToggles.CCToggleExists(); c.checks >= c.maxStateChecks && exists {
-							c.writeConsistencyErrorToFile(currentState, err)
+							c.recordConsistencyError(currentState, err)
 							return nil
 						}
 
@@ -286,7 +300,7 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 					})
 
 					if exists := featureToggles.CCToggleExists(); c.checks >= c.maxStateChecks && exists {
-						c.writeConsistencyErrorToFile(currentState, err)
+						c.recordConsistencyError(currentState, err)
 						return nil
 					}
 
@@ -301,26 +315,23 @@ func (c *ConsistencyCheck) CheckState(currentState *schema.ResourceData) *retry.
 	return nil
 }
 
-func (c *ConsistencyCheck) writeConsistencyErrorToFile(d *schema.ResourceData, consistencyError *retry.RetryError) {
-	const filePath = "consistency-errors.log.json"
-	errorJson := consistencyErrorJson{
+// recordConsistencyError adds the error to the consistency report written when the provider shuts down
+func (c *ConsistencyCheck) recordConsistencyError(d *schema.ResourceData, retryError *retry.RetryError) {
+	errorJson := &consistencyErrorJson{
 		ResourceType: c.resourceType,
 		ResourceId:   d.Id(),
-		ErrorMessage: consistencyError.Err.Error(),
+		Checks:       c.checks + 1,
+		ErrorMessage: retryError.Err.Error(),
+	}
+	if ccErr, ok := retryError.Err.(*consistencyError); ok {
+		errorJson.Attribute = ccErr.key
+		errorJson.ExpectedValue = reportValue(ccErr.oldValue)
+		errorJson.ActualValue = reportValue(ccErr.newValue)
 	}
 
 	if name, _ := d.Get("name").(string); name != "" {
 		errorJson.GCloudObjectName = name
 	}
 
-	jsonData, err := json.Marshal(errorJson)
-	if err != nil {
-		fmt.Println("Error marshaling JSON:", err)
-		return
-	}
-
-	err = os.WriteFile(filePath, jsonData, os.ModePerm)
-	if err != nil {
-		log.Printf("Error writing file %s: %v", filePath, err)
-	}
+	report.record(errorJson)
 }
@@ -0,0 +1,148 @@
+package consistency_checker
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// Environment variable to set the path of the consistency report. The summary is written next to it with a .txt extension.
+	consistencyReportPathEnv = "CONSISTENCY_CHECKER_REPORT_PATH"
+	// Default name of the consistency report, formatted with the process ID. Each provider configuration runs in its
+	// own process, so aliased providers write separate reports.
+	defaultConsistencyReport = "consistency-errors-%d.log.json"
+)
+
+// consistencyReport collects the consistency errors of every resource during the run of the provider, so that they can
+// all be written at once when the provider shuts down
+type consistencyReport struct {
+	mutex  sync.Mutex
+	errors map[string]*consistencyErrorJson
+}
+
+type consistencyReportJson struct {
+	GeneratedAt   string                  `json:"generatedAt"`
+	ErrorCount    int                     `json:"errorCount"`
+	ResourceCount int                     `json:"resourceCount"`
+	Errors        []*consistencyErrorJson `json:"errors"`
+}
+
+var report = newConsistencyReport()
+
+func newConsistencyReport() *consistencyReport {
+	return &consistencyReport{errors: make(map[string]*consistencyErrorJson)}
+}
+
+// record adds an error to the report. An error on an attribute that was already reported for the same resource
+// replaces the previous one.
+func (r *consistencyReport) record(errorJson *consistencyErrorJson) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.errors[strings.Join([]string{errorJson.ResourceType, errorJson.ResourceId, errorJson.Attribute}, "|")] = errorJson
+}
+
+// sortedErrors returns the errors ordered by resource type, resource ID and attribute
+func (r *consistencyReport) sortedErrors() []*consistencyErrorJson {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	errors := make([]*consistencyErrorJson, 0, len(r.errors))
+	for _, errorJson := range r.errors {
+		errors = append(errors, errorJson)
+	}
+	sort.Slice(errors, func(i, j int) bool {
+		if errors[i].ResourceType != errors[j].ResourceType {
+			return errors[i].ResourceType < errors[j].ResourceType
+		}
+		if errors[i].ResourceId != errors[j].ResourceId {
+			return errors[i].ResourceId < errors[j].ResourceId
+		}
+		return errors[i].Attribute < errors[j].Attribute
+	})
+	return errors
+}
+
+func (r *consistencyReport) write(reportPath string, now time.Time) error {
+	errors := r.sortedErrors()
+	if len(errors) == 0 {
+		return nil
+	}
+
+	resources := make(map[string]bool)
+	for _, errorJson := range errors {
+		resources[errorJson.ResourceType+"|"+errorJson.ResourceId] = true
+	}
+	jsonData, err := json.MarshalIndent(consistencyReportJson{
+		GeneratedAt:   now.UTC().Format(time.RFC3339),
+		ErrorCount:    len(errors),
+		ResourceCount: len(resources),
+		Errors:        errors,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal consistency report: %v", err)
+	}
+	if err := os.WriteFile(reportPath, jsonData, 0644); err != nil {
+		return fmt.Errorf("failed to write consistency report %s: %v", reportPath, err)
+	}
+
+	summary := consistencyReportSummary(errors, len(resources))
+	summaryPath := strings.TrimSuffix(reportPath, filepath.Ext(reportPath)) + ".txt"
+	if err := os.WriteFile(summaryPath, []byte(summary), 0644); err != nil {
+		return fmt.Errorf("failed to write consistency report summary %s: %v", summaryPath, err)
+	}
+	log.Printf("Wrote consistency report to %s and %s\n%s", reportPath, summaryPath, summary)
+	return nil
+}
+
+// consistencyReportSummary lists the errors grouped by resource type
+func consistencyReportSummary(errors []*consistencyErrorJson, resourceCount int) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%d consistency errors on %d resources\n", len(errors), resourceCount))
+
+	counts := make(map[string]int)
+	for _, errorJson := range errors {
+		counts[errorJson.ResourceType]++
+	}
+	previousType := ""
+	for _, errorJson := range errors {
+		if errorJson.ResourceType != previousType {
+			sb.WriteString(fmt.Sprintf("\n%s (%d)\n", errorJson.ResourceType, counts[errorJson.ResourceType]))
+			previousType = errorJson.ResourceType
+		}
+		resource := errorJson.ResourceId
+		if errorJson.GCloudObjectName != "" {
+			resource = fmt.Sprintf("%s (%s)", errorJson.ResourceId, errorJson.GCloudObjectName)
+		}
+		sb.WriteString(fmt.Sprintf("  %s %s: expected %v, actual %v after %d checks\n", resource, errorJson.Attribute, errorJson.ExpectedValue, errorJson.ActualValue, errorJson.Checks))
+	}
+	return sb.String()
+}
+
+// reportValue converts sets to lists so that they are readable in the JSON report
+func reportValue(value interface{}) interface{} {
+	if set, ok := value.(*schema.Set); ok {
+		return set.List()
+	}
+	return value
+}
+
+func consistencyReportPath() string {
+	if reportPath := os.Getenv(consistencyReportPathEnv); reportPath != "" {
+		return reportPath
+	}
+	return fmt.Sprintf(defaultConsistencyReport, os.Getpid())
+}
+
+// WriteConsistencyReport writes every consistency error found while the consistency checker was bypassed to a JSON
+// report, along with a readable summary. It is called when the provider shuts down and does nothing if there were no errors.
+func WriteConsistencyReport() error {
+	return report.write(consistencyReportPath(), time.Now())
+}
@@ -0,0 +1,59 @@
+package consistency_checker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitConsistencyReport(t *testing.T) {
+	r := newConsistencyReport()
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+
+	// Nothing is written when there were no errors
+	assert.Nil(t, r.write(reportPath, time.Now()))
+	_, err := os.Stat(reportPath)
+	assert.True(t, os.IsNotExist(err))
+
+	r.record(&consistencyErrorJson{ResourceType: "genesyscloud_user", ResourceId: "2", Attribute: "email", ExpectedValue: "a@example.com", ActualValue: "", Checks: 5})
+	r.record(&consistencyErrorJson{ResourceType: "genesyscloud_routing_queue", ResourceId: "1", GCloudObjectName: "Support", Attribute: "description", ExpectedValue: "old", ActualValue: "new", Checks: 3})
+	// A later error on the same attribute replaces the previous one
+	r.record(&consistencyErrorJson{ResourceType: "genesyscloud_routing_queue", ResourceId: "1", GCloudObjectName: "Support", Attribute: "description", ExpectedValue: "old", ActualValue: "newer", Checks: 6})
+	r.record(&consistencyErrorJson{ResourceType: "genesyscloud_routing_queue", ResourceId: "1", GCloudObjectName: "Support", Attribute: "acw_timeout_ms", ExpectedValue: 300000, ActualValue: 0, Checks: 6})
+	assert.Nil(t, r.write(reportPath, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)))
+
+	data, err := os.ReadFile(reportPath)
+	assert.Nil(t, err)
+	var written consistencyReportJson
+	assert.Nil(t, json.Unmarshal(data, &written))
+	assert.Equal(t, "2024-05-01T12:00:00Z", written.GeneratedAt)
+	assert.Equal(t, 3, written.ErrorCount)
+	assert.Equal(t, 2, written.ResourceCount)
+	assert.Equal(t, "acw_timeout_ms", written.Errors[0].Attribute)
+	assert.Equal(t, "newer", written.Errors[1].ActualValue)
+	assert.Equal(t, "genesyscloud_user", written.Errors[2].ResourceType)
+
+	summary, err := os.ReadFile(filepath.Join(filepath.Dir(reportPath), "report.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, `3 consistency errors on 2 resources
+
+genesyscloud_routing_queue (2)
+  1 (Support) acw_timeout_ms: expected 300000, actual 0 after 6 checks
+  1 (Support) description: expected old, actual newer after 6 checks
+
+genesyscloud_user (1)
+  2 email: expected a@example.com, actual  after 5 checks
+`, string(summary))
+}
+
+func TestUnitConsistencyReportPath(t *testing.T) {
+	t.Setenv(consistencyReportPathEnv, "")
+	assert.Equal(t, fmt.Sprintf("consistency-errors-%d.log.json", os.Getpid()), consistencyReportPath())
+	t.Setenv(consistencyReportPathEnv, "/tmp/apply-report.json")
+	assert.Equal(t, "/tmp/apply-report.json", consistencyReportPath())
+}
@@ -0,0 +1,190 @@
+package consistency_checker
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AttributeRule changes how the consistency checker compares an attribute that the API is known to normalize or to
+// update eventually
+type AttributeRule struct {
+	// Path of the attribute. Nested attributes are separated by '.' and '*' matches any list index or set element.
+	// A rule on an attribute also applies to the attributes nested in it.
+	Path string
+
+	// Equal reports whether the actual value of the attribute is consistent with the expected value.
+	// When nil, the attribute is not checked.
+	Equal func(expected, actual interface{}) bool
+}
+
+var (
+	attributeRules      = make(map[string][]AttributeRule)
+	attributeRulesMutex sync.RWMutex
+)
+
+// RegisterAttributeRules sets the rules for the attributes of a resource type
+func RegisterAttributeRules(resourceType string, rules ...AttributeRule) {
+	attributeRulesMutex.Lock()
+	defer attributeRulesMutex.Unlock()
+	attributeRules[resourceType] = rules
+}
+
+func getAttributeRules(resourceType string) []AttributeRule {
+	attributeRulesMutex.RLock()
+	defer attributeRulesMutex.RUnlock()
+	return append([]AttributeRule(nil), attributeRules[resourceType]...)
+}
+
+// IgnoreAttribute does not check the attribute, e.g. for timestamps set by the API
+func IgnoreAttribute(path string) AttributeRule {
+	return AttributeRule{Path: path}
+}
+
+// CaseInsensitive compares string values of the attribute regardless of case, e.g. for emails that the API lower cases
+func CaseInsensitive(path string) AttributeRule {
+	return AttributeRule{Path: path, Equal: func(expected, actual interface{}) bool {
+		return compareStrings(expected, actual, strings.EqualFold)
+	}}
+}
+
+// TrimmedSpace compares string values of the attribute without their leading and trailing white space
+func TrimmedSpace(path string) AttributeRule {
+	return AttributeRule{Path: path, Equal: func(expected, actual interface{}) bool {
+		return compareStrings(expected, actual, func(s1, s2 string) bool {
+			return strings.TrimSpace(s1) == strings.TrimSpace(s2)
+		})
+	}}
+}
+
+// UnorderedList compares the elements of a list attribute regardless of their order
+func UnorderedList(path string) AttributeRule {
+	return AttributeRule{Path: path, Equal: func(expected, actual interface{}) bool {
+		expectedList, ok1 := expected.([]interface{})
+		actualList, ok2 := actual.([]interface{})
+		if !ok1 || !ok2 {
+			return cmp.Equal(expected, actual)
+		}
+		return cmp.Equal(sortedByValue(expectedList), sortedByValue(actualList))
+	}}
+}
+
+// NumericTolerance accepts an actual value of the attribute that differs from the expected value by at most tolerance
+func NumericTolerance(path string, tolerance float64) AttributeRule {
+	return AttributeRule{Path: path, Equal: func(expected, actual interface{}) bool {
+		expectedNumber, ok1 := toFloat(expected)
+		actualNumber, ok2 := toFloat(actual)
+		if !ok1 || !ok2 {
+			return cmp.Equal(expected, actual)
+		}
+		return math.Abs(expectedNumber-actualNumber) <= tolerance
+	}}
+}
+
+func compareStrings(expected, actual interface{}, equal func(string, string) bool) bool {
+	expectedString, ok1 := expected.(string)
+	actualString, ok2 := actual.(string)
+	if !ok1 || !ok2 {
+		return cmp.Equal(expected, actual)
+	}
+	return equal(expectedString, actualString)
+}
+
+func sortedByValue(list []interface{}) []interface{} {
+	sorted := make([]interface{}, len(list))
+	copy(sorted, list)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return fmt.Sprintf("%#v", sorted[i]) < fmt.Sprintf("%#v", sorted[j])
+	})
+	return sorted
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	case string:
+		number, err := strconv.ParseFloat(v, 64)
+		return number, err == nil
+	}
+	return 0, false
+}
+
+// matchRulePath returns the part of an attribute key matched by a rule path, or false if the rule does not apply to it
+func matchRulePath(rulePath string, key string) (string, bool) {
+	ruleParts := strings.Split(rulePath, ".")
+	keyParts := strings.Split(key, ".")
+	if len(ruleParts) > len(keyParts) {
+		return "", false
+	}
+	for i, part := range ruleParts {
+		if part != "*" && part != keyParts[i] {
+			return "", false
+		}
+	}
+	return strings.Join(keyParts[:len(ruleParts)], "."), true
+}
+
+// lookupValue returns the value at a path of attribute keys in a state map read with ResourceData.Get. Set elements
+// are identified by their hash code.
+func lookupValue(value interface{}, keyParts []string) interface{} {
+	for _, part := range keyParts {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			value = v[part]
+		case []interface{}:
+			index, err := strconv.Atoi(part)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil
+			}
+			value = v[index]
+		case *schema.Set:
+			value = nil
+			for _, element := range v.List() {
+				if strconv.Itoa(v.F(element)) == strings.TrimPrefix(part, "~") {
+					value = element
+					break
+				}
+			}
+		default:
+			return nil
+		}
+	}
+	return value
+}
+
+// isConsistentByRule reports whether a changed attribute is still consistent according to the rules of the resource
+// type and the attributes ignored in the provider configuration
+func (c *ConsistencyCheck) isConsistentByRule(currentState *schema.ResourceData, key string) bool {
+	rules := getAttributeRules(c.resourceType)
+	if providerMeta, ok := c.meta.(*provider.ProviderMeta); ok {
+		for _, path := range providerMeta.ConsistencyIgnoredAttributes[c.resourceType] {
+			rules = append(rules, IgnoreAttribute(path))
+		}
+	}
+
+	for _, rule := range rules {
+		matchedKey, ok := matchRulePath(rule.Path, key)
+		if !ok {
+			continue
+		}
+		if rule.Equal == nil {
+			return true
+		}
+		expected := reportValue(lookupValue(c.originalState, strings.Split(matchedKey, ".")))
+		actual := reportValue(currentState.Get(matchedKey))
+		if rule.Equal(expected, actual) {
+			return true
+		}
+	}
+	return false
+}
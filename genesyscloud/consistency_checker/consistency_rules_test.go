@@ -0,0 +1,76 @@
+package consistency_checker
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitConsistencyMatchRulePath(t *testing.T) {
+	matchedKey, ok := matchRulePath("members.*.name", "members.2.name")
+	assert.True(t, ok)
+	assert.Equal(t, "members.2.name", matchedKey)
+
+	// Rules apply to the attributes nested in their attribute
+	matchedKey, ok = matchRulePath("members", "members.2.name")
+	assert.True(t, ok)
+	assert.Equal(t, "members", matchedKey)
+
+	_, ok = matchRulePath("members.*.name", "members")
+	assert.False(t, ok)
+	_, ok = matchRulePath("members.*.name", "members.2.ring_num")
+	assert.False(t, ok)
+}
+
+func TestUnitConsistencyAttributeRules(t *testing.T) {
+	assert.True(t, CaseInsensitive("email").Equal("John.Smith@Example.com", "john.smith@example.com"))
+	assert.False(t, CaseInsensitive("email").Equal("john@example.com", "jane@example.com"))
+	assert.True(t, TrimmedSpace("name").Equal(" Support ", "Support"))
+	assert.True(t, UnorderedList("skills").Equal([]interface{}{"a", "b"}, []interface{}{"b", "a"}))
+	assert.False(t, UnorderedList("skills").Equal([]interface{}{"a", "b"}, []interface{}{"a", "c"}))
+	assert.True(t, NumericTolerance("ratio", 0.01).Equal(0.5, 0.505))
+	assert.True(t, NumericTolerance("ratio", 0.01).Equal("0.5", 0.5))
+	assert.False(t, NumericTolerance("ratio", 0.01).Equal(0.5, 0.6))
+}
+
+func TestUnitConsistencyIsConsistentByRule(t *testing.T) {
+	resourceType := "genesyscloud_consistency_rules_test"
+	resourceSchema := map[string]*schema.Schema{
+		"email":         {Type: schema.TypeString, Optional: true},
+		"description":   {Type: schema.TypeString, Optional: true},
+		"date_modified": {Type: schema.TypeString, Optional: true},
+		"members": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Optional: true},
+			}},
+		},
+	}
+	RegisterAttributeRules(resourceType, CaseInsensitive("email"), TrimmedSpace("members.*.name"))
+	defer RegisterAttributeRules(resourceType)
+
+	c := &ConsistencyCheck{
+		resourceType: resourceType,
+		originalState: map[string]interface{}{
+			"email":         "John@Example.com",
+			"description":   "Support",
+			"date_modified": "2024-01-01T00:00:00Z",
+			"members":       []interface{}{map[string]interface{}{"name": " Jane "}},
+		},
+		meta: &provider.ProviderMeta{ConsistencyIgnoredAttributes: map[string][]string{resourceType: {"date_modified"}}},
+	}
+	currentState := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"email":         "john@example.com",
+		"description":   "Sales",
+		"date_modified": "2024-01-02T00:00:00Z",
+		"members":       []interface{}{map[string]interface{}{"name": "Jane"}},
+	})
+
+	assert.True(t, c.isConsistentByRule(currentState, "email"))
+	assert.True(t, c.isConsistentByRule(currentState, "members.0.name"))
+	assert.True(t, c.isConsistentByRule(currentState, "date_modified"))
+	assert.False(t, c.isConsistentByRule(currentState, "description"))
+}
@@ -32,18 +32,17 @@ func dataSourceRoutingSkill() *schema.Resource {
 	}
 }
 
-var (
-	dataSourceRoutingSkillCache *rc.DataSourceCache
-)
+// dataSourceRoutingSkillCaches holds one skill cache per configured provider so aliased providers don't share lookups
+var dataSourceRoutingSkillCaches = provider.NewProxyRegistry[rc.DataSourceCache]()
 
 func dataSourceRoutingSkillRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
 
 	key := d.Get("name").(string)
 
-	if dataSourceRoutingSkillCache == nil {
-		dataSourceRoutingSkillCache = rc.NewDataSourceCache(sdkConfig, hydrateRoutingSkillCacheFn, getSkillByNameFn)
-	}
+	dataSourceRoutingSkillCache := dataSourceRoutingSkillCaches.Get(sdkConfig, func(c *platformclientv2.Configuration) *rc.DataSourceCache {
+		return rc.NewDataSourceCache(c, hydrateRoutingSkillCacheFn, getSkillByNameFn)
+	})
 
 	queueId, err := rc.RetrieveId(dataSourceRoutingSkillCache, "genesyscloud_routing_skill", key, ctx)
 
@@ -36,9 +36,8 @@ func DataSourceUser() *schema.Resource {
 	}
 }
 
-var (
-	dataSourceUserCache *rc.DataSourceCache
-)
+// dataSourceUserCaches holds one user cache per configured provider so aliased providers don't share lookups
+var dataSourceUserCaches = provider.NewProxyRegistry[rc.DataSourceCache]()
 
 func DataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
@@ -56,9 +55,9 @@ func DataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 		return util.BuildDiagnosticError("genesyscloud_user", "no user search field specified", nil)
 	}
 
-	if dataSourceUserCache == nil {
-		dataSourceUserCache = rc.NewDataSourceCache(sdkConfig, hydrateUserCacheFn, getUserByNameFn)
-	}
+	dataSourceUserCache := dataSourceUserCaches.Get(sdkConfig, func(c *platformclientv2.Configuration) *rc.DataSourceCache {
+		return rc.NewDataSourceCache(c, hydrateUserCacheFn, getUserByNameFn)
+	})
 
 	userId, err := rc.RetrieveId(dataSourceUserCache, "genesyscloud_user", key, ctx)
 	if err != nil {
@@ -0,0 +1,115 @@
+package dependency_consumers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	dependentConsumers "terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+const flowResourceType = "genesyscloud_flow"
+
+// dataSourceDependencyConsumersRead retrieves the consumers of an object with Architect dependency tracking
+func dataSourceDependencyConsumersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getDependencyConsumersProxy(sdkConfig)
+
+	resourceType := d.Get("resource_type").(string)
+	resourceId := d.Get("resource_id").(string)
+	flowFilter := d.Get("flow_filter").(string)
+
+	objectTypes, resp, err := getObjectTypes(ctx, proxy, resourceType, resourceId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get the dependency tracking type of %s %s | error: %s", resourceType, resourceId, err), resp)
+	}
+
+	consumers := make(map[string]platformclientv2.Dependency)
+	for _, objectType := range objectTypes {
+		dependencies, resp, err := proxy.getConsumingResources(ctx, resourceId, objectType, flowFilter)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get the consumers of %s %s | error: %s", resourceType, resourceId, err), resp)
+		}
+		for _, dependency := range *dependencies {
+			consumers[stringValue(dependency.VarType)+"/"+stringValue(dependency.Id)] = dependency
+		}
+	}
+
+	d.SetId(resourceId)
+	_ = d.Set("consumers", flattenConsumers(consumers))
+	return nil
+}
+
+// getObjectTypes returns the dependency tracking object types of a Terraform resource type. The object type of a flow
+// depends on the type of the flow.
+func getObjectTypes(ctx context.Context, proxy *dependencyConsumersProxy, resourceType string, resourceId string) ([]string, *platformclientv2.APIResponse, error) {
+	if resourceType == flowResourceType {
+		flowType, resp, err := proxy.getFlowType(ctx, resourceId)
+		if err != nil {
+			return nil, resp, err
+		}
+		objectType, ok := dependentConsumers.SetFlowTypeObjectMaps()[flowType]
+		if !ok {
+			return nil, resp, fmt.Errorf("flows of type %s are not tracked", flowType)
+		}
+		return []string{objectType}, resp, nil
+	}
+
+	var objectTypes []string
+	for objectType, trackedType := range dependentConsumers.SetDependentObjectMaps() {
+		if trackedType == resourceType {
+			objectTypes = append(objectTypes, objectType)
+		}
+	}
+	if len(objectTypes) == 0 {
+		return nil, nil, fmt.Errorf("%s objects are not tracked", resourceType)
+	}
+	sort.Strings(objectTypes)
+	return objectTypes, nil, nil
+}
+
+// flattenConsumers resolves the consumers to Terraform resource types and names, ordered by resource type and name
+func flattenConsumers(consumers map[string]platformclientv2.Dependency) []interface{} {
+	sanitizer := resourceExporter.NewSanitizerProvider()
+	objectMaps := dependentConsumers.SetDependentObjectMaps()
+
+	flattened := make([]interface{}, 0, len(consumers))
+	for _, consumer := range consumers {
+		name := stringValue(consumer.Name)
+		resourceName := ""
+		if name != "" {
+			resourceName = sanitizer.S.SanitizeResourceName(name)
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"resource_type": objectMaps[stringValue(consumer.VarType)],
+			"resource_name": resourceName,
+			"id":            stringValue(consumer.Id),
+			"name":          name,
+			"object_type":   stringValue(consumer.VarType),
+			"version":       stringValue(consumer.Version),
+		})
+	}
+	sort.Slice(flattened, func(i, j int) bool {
+		consumer1, consumer2 := flattened[i].(map[string]interface{}), flattened[j].(map[string]interface{})
+		for _, key := range []string{"resource_type", "resource_name", "id"} {
+			if consumer1[key] != consumer2[key] {
+				return consumer1[key].(string) < consumer2[key].(string)
+			}
+		}
+		return false
+	})
+	return flattened
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
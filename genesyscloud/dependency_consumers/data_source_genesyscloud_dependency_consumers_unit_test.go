@@ -0,0 +1,79 @@
+package dependency_consumers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDependency(id string, name string, objectType string) platformclientv2.Dependency {
+	version := "1.0"
+	return platformclientv2.Dependency{Id: &id, Name: &name, VarType: &objectType, Version: &version}
+}
+
+func TestUnitDataSourceDependencyConsumers(t *testing.T) {
+	queueId := uuid.NewString()
+	flowId := uuid.NewString()
+	scriptId := uuid.NewString()
+
+	consumersProxy := &dependencyConsumersProxy{}
+	consumersProxy.getConsumingResourcesAttr = func(ctx context.Context, p *dependencyConsumersProxy, id string, objectType string, flowFilter string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, queueId, id)
+		assert.Equal(t, "QUEUE", objectType)
+		assert.Equal(t, "published", flowFilter)
+		return &[]platformclientv2.Dependency{
+			testDependency(scriptId, "Support_Script", "COMPOSERSCRIPT"),
+			testDependency(flowId, "Main_Menu", "INBOUNDCALLFLOW"),
+			testDependency(flowId, "Main_Menu", "INBOUNDCALLFLOW"),
+			testDependency("other", "Bot", "DIGITALBOTFLOW"),
+		}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = consumersProxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, DataSourceDependencyConsumers().Schema, map[string]interface{}{
+		"resource_type": "genesyscloud_routing_queue",
+		"resource_id":   queueId,
+		"flow_filter":   "published",
+	})
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	diagErr := dataSourceDependencyConsumersRead(context.Background(), d, meta)
+	assert.False(t, diagErr.HasError())
+	assert.Equal(t, queueId, d.Id())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"resource_type": "", "resource_name": "Bot", "id": "other", "name": "Bot", "object_type": "DIGITALBOTFLOW", "version": "1.0"},
+		map[string]interface{}{"resource_type": "genesyscloud_flow", "resource_name": "Main_Menu", "id": flowId, "name": "Main_Menu", "object_type": "INBOUNDCALLFLOW", "version": "1.0"},
+		map[string]interface{}{"resource_type": "genesyscloud_script", "resource_name": "Support_Script", "id": scriptId, "name": "Support_Script", "object_type": "COMPOSERSCRIPT", "version": "1.0"},
+	}, d.Get("consumers"))
+}
+
+func TestUnitDependencyConsumersObjectTypes(t *testing.T) {
+	flowId := uuid.NewString()
+	consumersProxy := &dependencyConsumersProxy{}
+	consumersProxy.getFlowTypeAttr = func(ctx context.Context, p *dependencyConsumersProxy, id string) (string, *platformclientv2.APIResponse, error) {
+		if id != flowId {
+			return "", &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, errors.New("not found")
+		}
+		return "INBOUNDCALL", &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
+	objectTypes, _, err := getObjectTypes(context.Background(), consumersProxy, "genesyscloud_flow", flowId)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"INBOUNDCALLFLOW"}, objectTypes)
+
+	_, _, err = getObjectTypes(context.Background(), consumersProxy, "genesyscloud_flow", uuid.NewString())
+	assert.NotNil(t, err)
+
+	// Languages are tracked with two object types
+	objectTypes, _, err = getObjectTypes(context.Background(), consumersProxy, "genesyscloud_routing_language", uuid.NewString())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ACDLANGUAGE", "LANGUAGE"}, objectTypes)
+}
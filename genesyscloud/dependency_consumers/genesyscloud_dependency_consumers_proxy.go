@@ -0,0 +1,102 @@
+package dependency_consumers
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+/*
+The genesyscloud_dependency_consumers_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *dependencyConsumersProxy
+
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[dependencyConsumersProxy]()
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getFlowTypeFunc func(ctx context.Context, p *dependencyConsumersProxy, flowId string) (string, *platformclientv2.APIResponse, error)
+type getConsumingResourcesFunc func(ctx context.Context, p *dependencyConsumersProxy, id string, objectType string, flowFilter string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error)
+
+// dependencyConsumersProxy contains all of the methods that call genesys cloud APIs.
+type dependencyConsumersProxy struct {
+	clientConfig              *platformclientv2.Configuration
+	architectApi              *platformclientv2.ArchitectApi
+	getFlowTypeAttr           getFlowTypeFunc
+	getConsumingResourcesAttr getConsumingResourcesFunc
+}
+
+// newDependencyConsumersProxy initializes the proxy with all data needed to communicate with Genesys Cloud
+func newDependencyConsumersProxy(clientConfig *platformclientv2.Configuration) *dependencyConsumersProxy {
+	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
+	return &dependencyConsumersProxy{
+		clientConfig:              clientConfig,
+		architectApi:              api,
+		getFlowTypeAttr:           getFlowTypeFn,
+		getConsumingResourcesAttr: getConsumingResourcesFn,
+	}
+}
+
+// getDependencyConsumersProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getDependencyConsumersProxy(clientConfig *platformclientv2.Configuration) *dependencyConsumersProxy {
+	if internalProxy != nil {
+		return internalProxy
+	}
+	return proxyRegistry.Get(clientConfig, newDependencyConsumersProxy)
+}
+
+// getFlowType returns the type of a flow, e.g. INBOUNDCALL
+func (p *dependencyConsumersProxy) getFlowType(ctx context.Context, flowId string) (string, *platformclientv2.APIResponse, error) {
+	return p.getFlowTypeAttr(ctx, p, flowId)
+}
+
+// getConsumingResources returns the objects that reference a dependency tracking object
+func (p *dependencyConsumersProxy) getConsumingResources(ctx context.Context, id string, objectType string, flowFilter string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+	return p.getConsumingResourcesAttr(ctx, p, id, objectType, flowFilter)
+}
+
+// getFlowTypeFn is the implementation for retrieving the type of a flow in Genesys Cloud
+func getFlowTypeFn(_ context.Context, p *dependencyConsumersProxy, flowId string) (string, *platformclientv2.APIResponse, error) {
+	flow, resp, err := p.architectApi.GetFlow(flowId, false)
+	if err != nil {
+		return "", resp, fmt.Errorf("failed to get flow %s: %s", flowId, err)
+	}
+	if flow.VarType == nil {
+		return "", resp, fmt.Errorf("flow %s has no type", flowId)
+	}
+	return *flow.VarType, resp, nil
+}
+
+// getConsumingResourcesFn is the implementation for retrieving the consumers of a dependency tracking object in Genesys Cloud
+func getConsumingResourcesFn(_ context.Context, p *dependencyConsumersProxy, id string, objectType string, flowFilter string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+	var allConsumers []platformclientv2.Dependency
+	const pageSize = 100
+
+	consumers, resp, err := p.architectApi.GetArchitectDependencytrackingConsumingresources(id, objectType, nil, "", 1, pageSize, flowFilter)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get consumers of %s %s: %s", objectType, id, err)
+	}
+	if consumers.Entities == nil || len(*consumers.Entities) == 0 {
+		return &allConsumers, resp, nil
+	}
+	allConsumers = append(allConsumers, *consumers.Entities...)
+
+	for pageNum := 2; pageNum <= *consumers.PageCount; pageNum++ {
+		consumers, resp, err := p.architectApi.GetArchitectDependencytrackingConsumingresources(id, objectType, nil, "", pageNum, pageSize, flowFilter)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get consumers of %s %s: %s", objectType, id, err)
+		}
+		if consumers.Entities == nil || len(*consumers.Entities) == 0 {
+			break
+		}
+		allConsumers = append(allConsumers, *consumers.Entities...)
+	}
+	return &allConsumers, resp, nil
+}
@@ -0,0 +1,99 @@
+package dependency_consumers
+
+import (
+	"sort"
+	dependentConsumers "terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const resourceName = "genesyscloud_dependency_consumers"
+
+// SetRegistrar registers all resources, data sources and exporters in the package
+func SetRegistrar(l registrar.Registrar) {
+	l.RegisterDataSource(resourceName, DataSourceDependencyConsumers())
+}
+
+var consumerResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"resource_type": {
+			Description: "Terraform resource type of the consumer, e.g. 'genesyscloud_flow'. Empty if the consumer cannot be managed by this provider.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"resource_name": {
+			Description: "Name of the consumer as a Terraform resource, as it would be named by genesyscloud_tf_export.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"id": {
+			Description: "ID of the consumer.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the consumer.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"object_type": {
+			Description: "Dependency tracking object type of the consumer, e.g. 'INBOUNDCALLFLOW'.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"version": {
+			Description: "Version of the consumer that references the resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceDependencyConsumers registers the genesyscloud_dependency_consumers data source
+func DataSourceDependencyConsumers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the Architect flows, scripts and other objects that reference a Genesys Cloud object according to Architect dependency tracking, e.g. to fail a plan that would delete a queue still used by a published flow.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceDependencyConsumersRead),
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Description:  "Terraform resource type of the referenced object, e.g. 'genesyscloud_routing_queue'.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(trackedResourceTypes(), false),
+			},
+			"resource_id": {
+				Description: "ID of the referenced object.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"flow_filter": {
+				Description:  "Only return the flows that reference the object in their checked in ('checkedIn') or published ('published') version. Defaults to all versions.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"checkedIn", "published"}, false),
+			},
+			"consumers": {
+				Description: "Objects that reference the object, ordered by resource type and name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        consumerResource,
+			},
+		},
+	}
+}
+
+// trackedResourceTypes returns the Terraform resource types of the dependency tracking object types
+func trackedResourceTypes() []string {
+	var resourceTypes []string
+	for _, resourceType := range dependentConsumers.SetDependentObjectMaps() {
+		if !lists.ItemInSlice(resourceType, resourceTypes) {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -16,6 +17,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *employeeperformanceExternalmetricsDefinitionProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[employeeperformanceExternalmetricsDefinitionProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createEmployeeperformanceExternalmetricsDefinitionFunc func(ctx context.Context, p *employeeperformanceExternalmetricsDefinitionProxy, domainOrganizationRole *platformclientv2.Externalmetricdefinitioncreaterequest) (*platformclientv2.Externalmetricdefinition, *platformclientv2.APIResponse, error)
 type getAllEmployeeperformanceExternalmetricsDefinitionFunc func(ctx context.Context, p *employeeperformanceExternalmetricsDefinitionProxy) (*[]platformclientv2.Externalmetricdefinition, *platformclientv2.APIResponse, error)
@@ -51,13 +55,13 @@ func newEmployeeperformanceExternalmetricsDefinitionProxy(clientConfig *platform
 	}
 }
 
-// getEmployeeperformanceExternalmetricsDefinitionProxy acts as a singleton to for the internalProxy.  It also ensures
+// getEmployeeperformanceExternalmetricsDefinitionProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getEmployeeperformanceExternalmetricsDefinitionProxy(clientConfig *platformclientv2.Configuration) *employeeperformanceExternalmetricsDefinitionProxy {
-	if internalProxy == nil {
-		internalProxy = newEmployeeperformanceExternalmetricsDefinitionProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newEmployeeperformanceExternalmetricsDefinitionProxy)
 }
 
 // createEmployeeperformanceExternalmetricsDefinition creates a Genesys Cloud employeeperformance externalmetrics definition
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
@@ -34,6 +35,9 @@ Each proxy implementation:
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *externalContactsContactsProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[externalContactsContactsProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllExternalContactsFunc func(ctx context.Context, p *externalContactsContactsProxy) (*[]platformclientv2.Externalcontact, *platformclientv2.APIResponse, error)
 type createExternalContactFunc func(ctx context.Context, p *externalContactsContactsProxy, externalContact *platformclientv2.Externalcontact) (*platformclientv2.Externalcontact, *platformclientv2.APIResponse, error)
@@ -58,7 +62,7 @@ type externalContactsContactsProxy struct {
 // newExternalContactsContactsProxy initializes the External Contacts proxy with all of the data needed to communicate with Genesys Cloud
 func newExternalContactsContactsProxy(clientConfig *platformclientv2.Configuration) *externalContactsContactsProxy {
 	api := platformclientv2.NewExternalContactsApiWithConfig(clientConfig)
-	externalContactsCache := rc.NewResourceCache[platformclientv2.Externalcontact]()
+	externalContactsCache := rc.NewClientResourceCache[platformclientv2.Externalcontact](clientConfig)
 	return &externalContactsContactsProxy{
 		clientConfig:                     clientConfig,
 		externalContactsApi:              api,
@@ -72,13 +76,13 @@ func newExternalContactsContactsProxy(clientConfig *platformclientv2.Configurati
 	}
 }
 
-// getExternalContactsContactsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getExternalContactsContactsProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getExternalContactsContactsProxy(clientConfig *platformclientv2.Configuration) *externalContactsContactsProxy {
-	if internalProxy == nil {
-		internalProxy = newExternalContactsContactsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newExternalContactsContactsProxy)
 }
 
 // getAllExternalContacts retrieves all Genesys Cloud External Contacts
@@ -116,6 +120,10 @@ func (p *externalContactsContactsProxy) updateExternalContact(ctx context.Contex
 
 // getAllExternalContactsFn is the implementation for retrieving all external contacts in Genesys Cloud
 func getAllExternalContactsFn(ctx context.Context, p *externalContactsContactsProxy) (*[]platformclientv2.Externalcontact, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.externalContactsCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	var allExternalContacts []platformclientv2.Externalcontact
 	cursor := ""
 	var response *platformclientv2.APIResponse
@@ -143,6 +151,7 @@ func getAllExternalContactsFn(ctx context.Context, p *externalContactsContactsPr
 	for _, externalContact := range allExternalContacts {
 		rc.SetCache(p.externalContactsCache, *externalContact.Id, externalContact)
 	}
+	rc.SetCacheComplete(p.externalContactsCache)
 
 	return &allExternalContacts, response, nil
 }
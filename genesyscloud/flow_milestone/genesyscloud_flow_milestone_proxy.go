@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -17,6 +18,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *flowMilestoneProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[flowMilestoneProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createFlowMilestoneFunc func(ctx context.Context, p *flowMilestoneProxy, flowMilestone *platformclientv2.Flowmilestone) (*platformclientv2.Flowmilestone, *platformclientv2.APIResponse, error)
 type getAllFlowMilestoneFunc func(ctx context.Context, p *flowMilestoneProxy) (*[]platformclientv2.Flowmilestone, *platformclientv2.APIResponse, error)
@@ -52,13 +56,13 @@ func newFlowMilestoneProxy(clientConfig *platformclientv2.Configuration) *flowMi
 	}
 }
 
-// getFlowMilestoneProxy acts as a singleton to for the internalProxy.  It also ensures
+// getFlowMilestoneProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getFlowMilestoneProxy(clientConfig *platformclientv2.Configuration) *flowMilestoneProxy {
-	if internalProxy == nil {
-		internalProxy = newFlowMilestoneProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newFlowMilestoneProxy)
 }
 
 // createFlowMilestone creates a Genesys Cloud flow milestone
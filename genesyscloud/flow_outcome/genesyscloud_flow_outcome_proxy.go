@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -17,6 +18,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *flowOutcomeProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[flowOutcomeProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createFlowOutcomeFunc func(ctx context.Context, p *flowOutcomeProxy, flowOutcome *platformclientv2.Flowoutcome) (*platformclientv2.Flowoutcome, *platformclientv2.APIResponse, error)
 type getAllFlowOutcomeFunc func(ctx context.Context, p *flowOutcomeProxy) (*[]platformclientv2.Flowoutcome, *platformclientv2.APIResponse, error)
@@ -49,13 +53,13 @@ func newFlowOutcomeProxy(clientConfig *platformclientv2.Configuration) *flowOutc
 	}
 }
 
-// getFlowOutcomeProxy acts as a singleton to for the internalProxy.  It also ensures
+// getFlowOutcomeProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getFlowOutcomeProxy(clientConfig *platformclientv2.Configuration) *flowOutcomeProxy {
-	if internalProxy == nil {
-		internalProxy = newFlowOutcomeProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newFlowOutcomeProxy)
 }
 
 // createFlowOutcome creates a Genesys Cloud flow outcome
@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 )
 
 var internalProxy *groupProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[groupProxy]()
+
 type createGroupFunc func(ctx context.Context, p *groupProxy, group *platformclientv2.Groupcreate) (*platformclientv2.Group, *platformclientv2.APIResponse, error)
 type getAllGroupFunc func(ctx context.Context, p *groupProxy) (*[]platformclientv2.Group, *platformclientv2.APIResponse, error)
 type updateGroupFunc func(ctx context.Context, p *groupProxy, id string, group *platformclientv2.Groupupdate) (*platformclientv2.Group, *platformclientv2.APIResponse, error)
@@ -36,7 +40,7 @@ type groupProxy struct {
 
 func newGroupProxy(clientConfig *platformclientv2.Configuration) *groupProxy {
 	api := platformclientv2.NewGroupsApiWithConfig(clientConfig)
-	groupCache := rc.NewResourceCache[platformclientv2.Group]()
+	groupCache := rc.NewClientResourceCache[platformclientv2.Group](clientConfig)
 	return &groupProxy{
 		clientConfig:           clientConfig,
 		groupsApi:              api,
@@ -54,11 +58,10 @@ func newGroupProxy(clientConfig *platformclientv2.Configuration) *groupProxy {
 }
 
 func getGroupProxy(clientConfig *platformclientv2.Configuration) *groupProxy {
-	if internalProxy == nil {
-		internalProxy = newGroupProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newGroupProxy)
 }
 
 func (p *groupProxy) createGroup(ctx context.Context, group *platformclientv2.Groupcreate) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
@@ -160,6 +163,10 @@ func getGroupByNameFn(_ context.Context, p *groupProxy, name string) (*platformc
 }
 
 func getAllGroupFn(_ context.Context, p *groupProxy) (*[]platformclientv2.Group, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.groupCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	var allGroups []platformclientv2.Group
 	const pageSize = 100
 
@@ -181,6 +188,7 @@ func getAllGroupFn(_ context.Context, p *groupProxy) (*[]platformclientv2.Group,
 	for _, group := range allGroups {
 		rc.SetCache(p.groupCache, *group.Id, group)
 	}
+	rc.SetCacheComplete(p.groupCache)
 
 	return &allGroups, nil, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
@@ -35,6 +36,10 @@ func GetAllGroups(ctx context.Context, clientConfig *platformclientv2.Configurat
 
 	for _, group := range *groups {
 		resources[*group.Id] = &resourceExporter.ResourceMeta{Name: *group.Name}
+		// Updating the members or owners of a group also increments its version
+		if group.Version != nil {
+			resources[*group.Id].Version = strconv.Itoa(*group.Version)
+		}
 	}
 
 	return resources, nil
@@ -6,8 +6,8 @@ import (
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud"
 	"terraform-provider-genesyscloud/genesyscloud/group"
-	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/cassette"
 	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"testing"
 	"time"
@@ -39,7 +39,7 @@ func TestAccResourceGroupRolesMembership(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
-		ProviderFactories: provider.GetProviderFactories(providerResources, nil),
+		ProviderFactories: cassette.ProviderFactories(t, providerResources, nil),
 		Steps: []resource.TestStep{
 			{
 				// Create group with 1 role in default division
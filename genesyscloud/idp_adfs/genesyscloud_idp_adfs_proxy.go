@@ -2,6 +2,7 @@ package idp_adfs
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -15,6 +16,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *idpAdfsProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[idpAdfsProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllIdpAdfsFunc func(ctx context.Context, p *idpAdfsProxy) (*platformclientv2.Adfs, *platformclientv2.APIResponse, error)
 type updateIdpAdfsFunc func(ctx context.Context, p *idpAdfsProxy, id string, aDFS *platformclientv2.Adfs) (resp *platformclientv2.APIResponse, err error)
@@ -41,14 +45,13 @@ func newIdpAdfsProxy(clientConfig *platformclientv2.Configuration) *idpAdfsProxy
 	}
 }
 
-// getIdpAdfsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIdpAdfsProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIdpAdfsProxy(clientConfig *platformclientv2.Configuration) *idpAdfsProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpAdfsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newIdpAdfsProxy)
 }
 
 // getIdpAdfs retrieves all Genesys Cloud idp adfs
@@ -3,6 +3,7 @@ package idp_okta
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -16,6 +17,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *idpOktaProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[idpOktaProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getIdpOktaFunc func(ctx context.Context, p *idpOktaProxy) (*platformclientv2.Okta, *platformclientv2.APIResponse, error)
 type updateIdpOktaFunc func(ctx context.Context, p *idpOktaProxy, id string, okta *platformclientv2.Okta) (*platformclientv2.Identityprovider, *platformclientv2.APIResponse, error)
@@ -42,14 +46,13 @@ func newIdpOktaProxy(clientConfig *platformclientv2.Configuration) *idpOktaProxy
 	}
 }
 
-// getIdpOktaProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIdpOktaProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIdpOktaProxy(clientConfig *platformclientv2.Configuration) *idpOktaProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpOktaProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newIdpOktaProxy)
 }
 
 // getIdpOkta retrieves all Genesys Cloud idp okta
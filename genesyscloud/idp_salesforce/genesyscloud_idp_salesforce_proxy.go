@@ -3,6 +3,7 @@ package idp_salesforce
 import (
 	"context"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -14,6 +15,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *idpSalesforceProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[idpSalesforceProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getIdpSalesforceFunc func(ctx context.Context, p *idpSalesforceProxy) (salesforce *platformclientv2.Salesforce, resp *platformclientv2.APIResponse, err error)
 type updateIdpSalesforceFunc func(ctx context.Context, p *idpSalesforceProxy, salesforce *platformclientv2.Salesforce) (*platformclientv2.Identityprovider, *platformclientv2.APIResponse, error)
@@ -40,14 +44,13 @@ func newIdpSalesforceProxy(clientConfig *platformclientv2.Configuration) *idpSal
 	}
 }
 
-// getIdpSalesforceProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIdpSalesforceProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIdpSalesforceProxy(clientConfig *platformclientv2.Configuration) *idpSalesforceProxy {
-	if internalProxy == nil {
-		internalProxy = newIdpSalesforceProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newIdpSalesforceProxy)
 }
 
 // getIdpSalesforce returns a single Genesys Cloud idp salesforce
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -42,6 +43,9 @@ helper methods and types are created to invoke the APIs with Genesys Cloud.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *integrationActionsProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[integrationActionsProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllIntegrationActionsFunc func(ctx context.Context, p *integrationActionsProxy) (*[]platformclientv2.Action, *platformclientv2.APIResponse, error)
 type createIntegrationActionFunc func(ctx context.Context, p *integrationActionsProxy, action *IntegrationAction) (*IntegrationAction, *platformclientv2.APIResponse, error)
@@ -80,13 +84,13 @@ func newIntegrationActionsProxy(clientConfig *platformclientv2.Configuration) *i
 	}
 }
 
-// getIntegrationActionsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIntegrationActionsProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIntegrationActionsProxy(clientConfig *platformclientv2.Configuration) *integrationActionsProxy {
-	if internalProxy == nil {
-		internalProxy = newIntegrationActionsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newIntegrationActionsProxy)
 }
 
 // getAllIntegrationActions retrieves all Genesys Cloud Integration Actions
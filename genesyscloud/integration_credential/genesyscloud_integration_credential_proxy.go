@@ -3,6 +3,7 @@ package integration_credential
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -30,6 +31,9 @@ Each proxy implementation:
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *integrationCredsProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[integrationCredsProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllIntegrationCredsFunc func(ctx context.Context, p *integrationCredsProxy) (*[]platformclientv2.Credentialinfo, *platformclientv2.APIResponse, error)
 type createIntegrationCredFunc func(ctx context.Context, p *integrationCredsProxy, createCredential *platformclientv2.Credential) (*platformclientv2.Credentialinfo, *platformclientv2.APIResponse, error)
@@ -68,13 +72,13 @@ func newIntegrationCredsProxy(clientConfig *platformclientv2.Configuration) *int
 	}
 }
 
-// getIntegrationCredsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getIntegrationCredsProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getIntegrationCredsProxy(clientConfig *platformclientv2.Configuration) *integrationCredsProxy {
-	if internalProxy == nil {
-		internalProxy = newIntegrationCredsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newIntegrationCredsProxy)
 }
 
 // getAllIntegrationCredentials retrieves all Genesys Cloud Integrations
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -31,6 +32,9 @@ Each proxy implementation:
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *customAuthActionsProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[customAuthActionsProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllIntegrationCustomAuthActionsFunc func(ctx context.Context, p *customAuthActionsProxy) (*[]platformclientv2.Action, *platformclientv2.APIResponse, error)
 type getCustomAuthActionByIdFunc func(ctx context.Context, p *customAuthActionsProxy, actionId string) (*platformclientv2.Action, *platformclientv2.APIResponse, error)
@@ -69,13 +73,13 @@ func newCustomAuthActionsProxy(clientConfig *platformclientv2.Configuration) *cu
 	}
 }
 
-// getCustomAuthActionsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getCustomAuthActionsProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getCustomAuthActionsProxy(clientConfig *platformclientv2.Configuration) *customAuthActionsProxy {
-	if internalProxy == nil {
-		internalProxy = newCustomAuthActionsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newCustomAuthActionsProxy)
 }
 
 // getAllIntegrationCustomAuthActions retrieves all Genesys Cloud Integration Custom Auth Actions
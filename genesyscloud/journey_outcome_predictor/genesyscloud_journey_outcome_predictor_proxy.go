@@ -3,6 +3,7 @@ package journey_outcome_predictor
 import (
 	"context"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -14,6 +15,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *journeyOutcomePredictorProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[journeyOutcomePredictorProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createJourneyOutcomePredictorFunc func(ctx context.Context, p *journeyOutcomePredictorProxy, outcomePredictor *platformclientv2.Outcomepredictorrequest) (*platformclientv2.Outcomepredictor, *platformclientv2.APIResponse, error)
 type getAllJourneyOutcomePredictorFunc func(ctx context.Context, p *journeyOutcomePredictorProxy) (*[]platformclientv2.Outcomepredictor, *platformclientv2.APIResponse, error)
@@ -43,14 +47,13 @@ func newJourneyOutcomePredictorProxy(clientConfig *platformclientv2.Configuratio
 	}
 }
 
-// getJourneyOutcomePredictorProxy acts as a singleton to for the internalProxy.  It also ensures
+// getJourneyOutcomePredictorProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getJourneyOutcomePredictorProxy(clientConfig *platformclientv2.Configuration) *journeyOutcomePredictorProxy {
-	if internalProxy == nil {
-		internalProxy = newJourneyOutcomePredictorProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newJourneyOutcomePredictorProxy)
 }
 
 // createJourneyOutcomePredictor creates a Genesys Cloud journey outcome predictor
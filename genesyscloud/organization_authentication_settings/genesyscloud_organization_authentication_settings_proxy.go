@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -15,6 +16,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *orgAuthSettingsProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[orgAuthSettingsProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getOrgAuthSettingsByIdFunc func(ctx context.Context, p *orgAuthSettingsProxy, id string) (orgAuthSettings *platformclientv2.Orgauthsettings, response *platformclientv2.APIResponse, err error)
 type updateOrgAuthSettingsFunc func(ctx context.Context, p *orgAuthSettingsProxy, orgAuthSettings *platformclientv2.Orgauthsettings) (*platformclientv2.Orgauthsettings, *platformclientv2.APIResponse, error)
@@ -38,13 +42,13 @@ func newOrgAuthSettingsProxy(clientConfig *platformclientv2.Configuration) *orgA
 	}
 }
 
-// getOrgAuthSettingsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOrgAuthSettingsProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOrgAuthSettingsProxy(clientConfig *platformclientv2.Configuration) *orgAuthSettingsProxy {
-	if internalProxy == nil {
-		internalProxy = newOrgAuthSettingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newOrgAuthSettingsProxy)
 }
 
 // getOrgAuthSettingsById returns a single Genesys Cloud organization authentication settings by Id
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud"
-	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/cassette"
 	"testing"
 	"time"
 
@@ -34,7 +34,7 @@ func TestAccResourceOrgAuthorizationPairing(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
-		ProviderFactories: provider.GetProviderFactories(providerResources, nil),
+		ProviderFactories: cassette.ProviderFactories(t, providerResources, nil),
 		Steps: []resource.TestStep{
 			// 1 user and 1 group
 			{
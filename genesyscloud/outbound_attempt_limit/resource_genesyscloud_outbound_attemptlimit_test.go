@@ -3,8 +3,8 @@ package outbound_attempt_limit
 import (
 	"fmt"
 	"strings"
-	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/cassette"
 	"testing"
 
 	"github.com/google/uuid"
@@ -53,7 +53,7 @@ func TestAccResourceOutboundAttemptLimit(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
-		ProviderFactories: provider.GetProviderFactories(providerResources, make(map[string]*schema.Resource)),
+		ProviderFactories: cassette.ProviderFactories(t, providerResources, make(map[string]*schema.Resource)),
 		Steps: []resource.TestStep{
 			{
 				Config: GenerateAttemptLimitResource(
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -17,6 +18,9 @@ with the Genesys Cloud SDK
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *outboundCallableTimesetProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[outboundCallableTimesetProxy]()
+
 // type definitions for each func on our proxy
 type createOutboundCallabletimesetFunc func(ctx context.Context, p *outboundCallableTimesetProxy, timeset *platformclientv2.Callabletimeset) (*platformclientv2.Callabletimeset, *platformclientv2.APIResponse, error)
 type getAllOutboundCallableTimesetFunc func(ctx context.Context, p *outboundCallableTimesetProxy) (*[]platformclientv2.Callabletimeset, *platformclientv2.APIResponse, error)
@@ -53,11 +57,10 @@ func newOutboundCallableTimesetProxy(clientConfig *platformclientv2.Configuratio
 }
 
 func getOutboundCallabletimesetProxy(clientConfig *platformclientv2.Configuration) *outboundCallableTimesetProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCallableTimesetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newOutboundCallableTimesetProxy)
 }
 
 // createOutboundCallabletimeset creates a Genesys Cloud Outbound Callable Timeset
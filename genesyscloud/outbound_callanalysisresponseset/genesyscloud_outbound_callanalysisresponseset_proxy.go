@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -16,6 +17,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *outboundCallanalysisresponsesetProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[outboundCallanalysisresponsesetProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOutboundCallanalysisresponsesetFunc func(ctx context.Context, p *outboundCallanalysisresponsesetProxy, responseSet *platformclientv2.Responseset) (*platformclientv2.Responseset, *platformclientv2.APIResponse, error)
 type getAllOutboundCallanalysisresponsesetFunc func(ctx context.Context, p *outboundCallanalysisresponsesetProxy, name string) (*[]platformclientv2.Responseset, *platformclientv2.APIResponse, error)
@@ -51,13 +55,13 @@ func newOutboundCallanalysisresponsesetProxy(clientConfig *platformclientv2.Conf
 	}
 }
 
-// getOutboundCallanalysisresponsesetProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundCallanalysisresponsesetProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundCallanalysisresponsesetProxy(clientConfig *platformclientv2.Configuration) *outboundCallanalysisresponsesetProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCallanalysisresponsesetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newOutboundCallanalysisresponsesetProxy)
 }
 
 // createOutboundCallanalysisresponseset creates a Genesys Cloud outbound callanalysisresponseset
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"time"
@@ -23,6 +24,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *outboundCampaignProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[outboundCampaignProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy, campaign *platformclientv2.Campaign) (*platformclientv2.Campaign, *platformclientv2.APIResponse, error)
 type getAllOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy) (*[]platformclientv2.Campaign, *platformclientv2.APIResponse, error)
@@ -47,7 +51,7 @@ type outboundCampaignProxy struct {
 // newOutboundCampaignProxy initializes the outbound campaign proxy with all of the data needed to communicate with Genesys Cloud
 func newOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *outboundCampaignProxy {
 	api := platformclientv2.NewOutboundApiWithConfig(clientConfig)
-	campaignCache := rc.NewResourceCache[platformclientv2.Campaign]()
+	campaignCache := rc.NewClientResourceCache[platformclientv2.Campaign](clientConfig)
 	return &outboundCampaignProxy{
 		clientConfig:                    clientConfig,
 		outboundApi:                     api,
@@ -61,13 +65,13 @@ func newOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *out
 	}
 }
 
-// getOutboundCampaignProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundCampaignProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *outboundCampaignProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCampaignProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newOutboundCampaignProxy)
 }
 
 // createOutboundCampaign creates a Genesys Cloud outbound campaign
@@ -145,6 +149,10 @@ func createOutboundCampaignFn(_ context.Context, p *outboundCampaignProxy, outbo
 
 // getAllOutboundCampaignFn is the implementation for retrieving all outbound campaign in Genesys Cloud
 func getAllOutboundCampaignFn(_ context.Context, p *outboundCampaignProxy) (*[]platformclientv2.Campaign, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.campaignCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	var allCampaigns []platformclientv2.Campaign
 	const pageSize = 100
 
@@ -175,6 +183,7 @@ func getAllOutboundCampaignFn(_ context.Context, p *outboundCampaignProxy) (*[]p
 	for _, campaign := range allCampaigns {
 		rc.SetCache(p.campaignCache, *campaign.Id, campaign)
 	}
+	rc.SetCacheComplete(p.campaignCache)
 
 	return &allCampaigns, resp, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -17,6 +18,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *outboundCampaignruleProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[outboundCampaignruleProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOutboundCampaignruleFunc func(ctx context.Context, p *outboundCampaignruleProxy, campaignRule *platformclientv2.Campaignrule) (*platformclientv2.Campaignrule, *platformclientv2.APIResponse, error)
 type getAllOutboundCampaignruleFunc func(ctx context.Context, p *outboundCampaignruleProxy) (*[]platformclientv2.Campaignrule, *platformclientv2.APIResponse, error)
@@ -52,13 +56,13 @@ func newOutboundCampaignruleProxy(clientConfig *platformclientv2.Configuration)
 	}
 }
 
-// getOutboundCampaignruleProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundCampaignruleProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundCampaignruleProxy(clientConfig *platformclientv2.Configuration) *outboundCampaignruleProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundCampaignruleProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newOutboundCampaignruleProxy)
 }
 
 // createOutboundCampaignrule creates a Genesys Cloud outbound campaignrule
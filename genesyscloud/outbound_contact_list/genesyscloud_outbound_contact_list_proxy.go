@@ -0,0 +1,91 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"io"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+/*
+The genesyscloud_outbound_contact_list_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK to import contacts into a contact list. We use composition here for each function on the
+proxy so individual functions can be stubbed out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *outboundContactListProxy
+
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[outboundContactListProxy]()
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type uploadContactsFileFunc func(ctx context.Context, p *outboundContactListProxy, contactListId string, contactsFile io.Reader, contactIdColumn string) ([]byte, error)
+type getContactListImportStatusFunc func(ctx context.Context, p *outboundContactListProxy, contactListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error)
+
+// outboundContactListProxy contains all of the methods that call genesys cloud APIs.
+type outboundContactListProxy struct {
+	clientConfig                   *platformclientv2.Configuration
+	outboundApi                    *platformclientv2.OutboundApi
+	basePath                       string
+	uploadContactsFileAttr         uploadContactsFileFunc
+	getContactListImportStatusAttr getContactListImportStatusFunc
+}
+
+// newOutboundContactListProxy initializes the outbound contact list proxy with all of the data needed to communicate with Genesys Cloud
+func newOutboundContactListProxy(clientConfig *platformclientv2.Configuration) *outboundContactListProxy {
+	api := platformclientv2.NewOutboundApiWithConfig(clientConfig)
+	return &outboundContactListProxy{
+		clientConfig:                   clientConfig,
+		outboundApi:                    api,
+		basePath:                       strings.Replace(api.Configuration.BasePath, "api", "apps", -1),
+		uploadContactsFileAttr:         uploadContactsFileFn,
+		getContactListImportStatusAttr: getContactListImportStatusFn,
+	}
+}
+
+// getOutboundContactListProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getOutboundContactListProxy(clientConfig *platformclientv2.Configuration) *outboundContactListProxy {
+	if internalProxy != nil {
+		return internalProxy
+	}
+	return proxyRegistry.Get(clientConfig, newOutboundContactListProxy)
+}
+
+// uploadContactsFile uploads a CSV file of contacts to be imported into a contact list
+func (p *outboundContactListProxy) uploadContactsFile(ctx context.Context, contactListId string, contactsFile io.Reader, contactIdColumn string) ([]byte, error) {
+	return p.uploadContactsFileAttr(ctx, p, contactListId, contactsFile, contactIdColumn)
+}
+
+// getContactListImportStatus returns the status of the last import of contacts into a contact list
+func (p *outboundContactListProxy) getContactListImportStatus(ctx context.Context, contactListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error) {
+	return p.getContactListImportStatusAttr(ctx, p, contactListId)
+}
+
+// uploadContactsFileFn is the implementation for uploading a contacts file. contactsFile must be an *os.File to be
+// uploaded as a file.
+func uploadContactsFileFn(_ context.Context, p *outboundContactListProxy, contactListId string, contactsFile io.Reader, contactIdColumn string) ([]byte, error) {
+	formData := make(map[string]io.Reader)
+	formData["file"] = contactsFile
+	formData["id"] = strings.NewReader(contactListId)
+	formData["fileType"] = strings.NewReader("contactlist")
+	if contactIdColumn != "" {
+		formData["contact-id-name"] = strings.NewReader(contactIdColumn)
+	}
+
+	headers := make(map[string]string)
+	// The token is read for each upload, it is refreshed during long runs
+	headers["Authorization"] = "Bearer " + p.clientConfig.AccessToken
+
+	s3Uploader := files.NewS3Uploader(nil, formData, nil, headers, "POST", p.basePath+"/uploads/v2/contactlist")
+	return s3Uploader.Upload()
+}
+
+// getContactListImportStatusFn is the implementation for retrieving the import status of a contact list
+func getContactListImportStatusFn(_ context.Context, p *outboundContactListProxy, contactListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error) {
+	return p.outboundApi.GetOutboundContactlistImportstatus(contactListId)
+}
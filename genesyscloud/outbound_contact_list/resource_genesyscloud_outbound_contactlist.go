@@ -8,6 +8,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -214,6 +215,30 @@ func ResourceOutboundContactList() *schema.Resource {
 				Type:        schema.TypeList,
 				Elem:        outboundContactListColumnDataTypeSpecification,
 			},
+			`contacts_filepath`: {
+				Description:  `Path or URL of a CSV file of contacts to import into the contact list. The header row holds the column names. The file is imported again when contacts_file_content_hash changes.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validators.ValidatePath,
+				RequiredWith: []string{"contacts_file_content_hash"},
+			},
+			`contacts_file_content_hash`: {
+				Description:  `Hash value of the contacts file content, e.g. filesha256(contacts_filepath). Used to detect changes.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				RequiredWith: []string{"contacts_filepath"},
+			},
+			`contacts_id_name`: {
+				Description: `The column holding the ID of each contact. Contacts with the ID of an existing contact update it. When not set, each import adds all the contacts of the file with new IDs.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`contacts_column_mapping`: {
+				Description: `Map of the column names in the header row of the contacts file to the column names of the contact list, for the columns named differently in the file.`,
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -259,6 +284,12 @@ func createOutboundContactList(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(*outboundContactList.Id)
 
 	log.Printf("Created Outbound Contact List %s %s", name, *outboundContactList.Id)
+
+	if d.Get("contacts_filepath").(string) != "" {
+		if diagErr := importOutboundContactListContacts(ctx, d, meta); diagErr != nil {
+			return diagErr
+		}
+	}
 	return readOutboundContactList(ctx, d, meta)
 }
 
@@ -312,6 +343,12 @@ func updateOutboundContactList(ctx context.Context, d *schema.ResourceData, meta
 		return diagErr
 	}
 
+	if d.Get("contacts_filepath").(string) != "" && hasContactsFileChange(d) {
+		if diagErr := importOutboundContactListContacts(ctx, d, meta); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	log.Printf("Updated Outbound Contact List %s", name)
 	return readOutboundContactList(ctx, d, meta)
 }
@@ -0,0 +1,128 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceOutboundContactListImportContacts(t *testing.T) {
+	tId := uuid.NewString()
+	contactsFilePath := filepath.Join(t.TempDir(), "contacts.csv")
+	assert.Nil(t, os.WriteFile(contactsFilePath, []byte("id,First Name,Mobile\n1,John,+13175550100\n2,Jane,+13175550102\n"), 0644))
+
+	originalPollInterval := contactsImportPollInterval
+	originalGracePeriod := contactsImportStartGracePeriod
+	contactsImportPollInterval = time.Millisecond
+	contactsImportStartGracePeriod = time.Minute
+	defer func() {
+		contactsImportPollInterval = originalPollInterval
+		contactsImportStartGracePeriod = originalGracePeriod
+	}()
+
+	var uploadedContacts string
+	statusReads := 0
+	contactListProxy := &outboundContactListProxy{}
+	contactListProxy.uploadContactsFileAttr = func(ctx context.Context, p *outboundContactListProxy, contactListId string, contactsFile io.Reader, contactIdColumn string) ([]byte, error) {
+		assert.Equal(t, tId, contactListId)
+		assert.Equal(t, "Contact ID", contactIdColumn)
+		contents, err := io.ReadAll(contactsFile)
+		assert.Nil(t, err)
+		uploadedContacts = string(contents)
+		return nil, nil
+	}
+	// The status of the previous import is returned until the new import starts
+	completed := "COMPLETED"
+	totalRecords := 2
+	previousStatus := &platformclientv2.Importstatus{State: &completed, TotalRecords: &totalRecords}
+	contactListProxy.getContactListImportStatusAttr = func(ctx context.Context, p *outboundContactListProxy, contactListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error) {
+		statusReads++
+		switch {
+		case statusReads <= 3:
+			return previousStatus, nil, nil
+		case statusReads == 4:
+			state := "IN_PROGRESS"
+			return &platformclientv2.Importstatus{State: &state, TotalRecords: &totalRecords}, nil, nil
+		default:
+			return &platformclientv2.Importstatus{State: &completed, TotalRecords: &totalRecords}, nil, nil
+		}
+	}
+	internalProxy = contactListProxy
+	defer func() { internalProxy = nil }()
+
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	d := schema.TestResourceDataRaw(t, ResourceOutboundContactList().Schema, map[string]interface{}{
+		"contacts_filepath":          contactsFilePath,
+		"contacts_file_content_hash": "hash",
+		"contacts_id_name":           "Contact ID",
+		"contacts_column_mapping":    map[string]interface{}{"id": "Contact ID", "Mobile": "Cell"},
+	})
+	d.SetId(tId)
+
+	diagErr := importOutboundContactListContacts(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError())
+	assert.Equal(t, "Contact ID,First Name,Cell\n1,John,+13175550100\n2,Jane,+13175550102\n", uploadedContacts)
+	assert.Equal(t, 5, statusReads)
+	assert.Equal(t, "hash", d.Get("contacts_file_content_hash"))
+
+	// A new import with the same counts may complete before its status is first read. Once nothing was seen in
+	// progress for the grace period, the completed status is the one of the new import.
+	statusReads = 0
+	contactsImportStartGracePeriod = 20 * time.Millisecond
+	contactListProxy.getContactListImportStatusAttr = func(ctx context.Context, p *outboundContactListProxy, contactListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error) {
+		statusReads++
+		return &platformclientv2.Importstatus{State: &completed, TotalRecords: &totalRecords}, nil, nil
+	}
+	uploadedAt := time.Now()
+	diagErr = importOutboundContactListContacts(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError())
+	assert.GreaterOrEqual(t, time.Since(uploadedAt), contactsImportStartGracePeriod)
+	assert.Greater(t, statusReads, 1)
+
+	// A failed import is uploaded again on the next apply
+	statusReads = 0
+	contactListProxy.getContactListImportStatusAttr = func(ctx context.Context, p *outboundContactListProxy, contactListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error) {
+		statusReads++
+		if statusReads == 1 {
+			return previousStatus, nil, nil
+		}
+		state := "FAILED"
+		reason := "invalid phone number"
+		return &platformclientv2.Importstatus{State: &state, FailureReason: &reason}, nil, nil
+	}
+	diagErr = importOutboundContactListContacts(context.Background(), d, gcloud)
+	assert.True(t, diagErr.HasError())
+	assert.Contains(t, diagErr[0].Detail, "invalid phone number")
+	assert.Equal(t, "", d.Get("contacts_file_content_hash"))
+}
+
+func TestUnitResourceOutboundContactListBuildContactsFile(t *testing.T) {
+	contactsFilePath := filepath.Join(t.TempDir(), "contacts.csv")
+	assert.Nil(t, os.WriteFile(contactsFilePath, []byte("id,Mobile\n1,+13175550100\n"), 0644))
+
+	// The contact ID column must be in the header once the columns are renamed
+	_, err := buildContactsFile(contactsFilePath, map[string]string{"Mobile": "Cell"}, "Contact ID")
+	assert.ErrorContains(t, err, "Contact ID")
+
+	contactsFile, err := buildContactsFile(contactsFilePath, nil, "id")
+	assert.Nil(t, err)
+	defer os.Remove(contactsFile.Name())
+	defer contactsFile.Close()
+	contents, err := io.ReadAll(contactsFile)
+	assert.Nil(t, err)
+	assert.Equal(t, "id,Mobile\n1,+13175550100\n", string(contents))
+
+	emptyFilePath := filepath.Join(t.TempDir(), "empty.csv")
+	assert.Nil(t, os.WriteFile(emptyFilePath, nil, 0644))
+	_, err = buildContactsFile(emptyFilePath, nil, "")
+	assert.ErrorContains(t, err, "empty")
+}
@@ -0,0 +1,203 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Interval between two reads of the import status, and how long to wait for an import to finish
+var (
+	contactsImportPollInterval = 2 * time.Second
+	contactsImportTimeout      = 30 * time.Minute
+
+	// The import status doesn't identify the import it is about. Until an import is seen in progress, a completed
+	// or failed status may be the one of the previous import for this long after the upload.
+	contactsImportStartGracePeriod = 30 * time.Second
+)
+
+// importOutboundContactListContacts uploads the contacts file of the contact list and waits for Genesys Cloud to import it.
+// The file content hash is removed from the state on failure so that the next apply uploads the file again.
+func importOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filePath := d.Get("contacts_filepath").(string)
+	contactIdName := d.Get("contacts_id_name").(string)
+	columnMapping := make(map[string]string)
+	for header, column := range d.Get("contacts_column_mapping").(map[string]interface{}) {
+		columnMapping[header] = column.(string)
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getOutboundContactListProxy(sdkConfig)
+
+	log.Printf("Importing contacts of %s into Outbound Contact List %s", filePath, d.Id())
+	contactsFile, err := buildContactsFile(filePath, columnMapping, contactIdName)
+	if err != nil {
+		setContactsFileContentHashToNil(d)
+		return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Failed to read contacts file %s for Outbound Contact List %s", filePath, d.Id()), err)
+	}
+	defer os.Remove(contactsFile.Name())
+
+	// The status of the previous import, if any, is kept until the import of the uploaded file starts
+	previousStatus, resp, err := proxy.getContactListImportStatus(ctx, d.Id())
+	if err != nil && !util.IsStatus404(resp) {
+		contactsFile.Close()
+		setContactsFileContentHashToNil(d)
+		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get the import status of Outbound Contact List %s error: %s", d.Id(), err), resp)
+	}
+
+	// The uploader closes the file once it has been sent
+	if _, err := proxy.uploadContactsFile(ctx, d.Id(), contactsFile, contactIdName); err != nil {
+		setContactsFileContentHashToNil(d)
+		return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Failed to upload contacts file %s for Outbound Contact List %s", filePath, d.Id()), err)
+	}
+
+	hasPreviousImport := previousStatus != nil && previousStatus.State != nil
+	if diagErr := waitForContactsImport(ctx, proxy, d.Id(), hasPreviousImport); diagErr != nil {
+		setContactsFileContentHashToNil(d)
+		return diagErr
+	}
+	log.Printf("Imported contacts of %s into Outbound Contact List %s", filePath, d.Id())
+	return nil
+}
+
+// buildContactsFile copies the contacts CSV file to a temporary file, renaming the columns of its header row with
+// columnMapping. The contact ID column, when set, must be in the renamed header.
+func buildContactsFile(filePath string, columnMapping map[string]string, contactIdName string) (*os.File, error) {
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	} else if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("contacts file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the header row: %v", err)
+	}
+
+	hasContactIdColumn := contactIdName == ""
+	for i, column := range header {
+		if mapped, ok := columnMapping[column]; ok {
+			header[i] = mapped
+		}
+		if header[i] == contactIdName {
+			hasContactIdColumn = true
+		}
+	}
+	if !hasContactIdColumn {
+		return nil, fmt.Errorf("contact ID column %s is not in the header row", contactIdName)
+	}
+
+	contactsFile, err := os.CreateTemp("", "contacts_*.csv")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeContactsFile(contactsFile, header, csvReader); err != nil {
+		contactsFile.Close()
+		os.Remove(contactsFile.Name())
+		return nil, err
+	}
+	return contactsFile, nil
+}
+
+// writeContactsFile writes the header row and the remaining rows of csvReader, then rewinds the file for the upload
+func writeContactsFile(contactsFile *os.File, header []string, csvReader *csv.Reader) error {
+	csvWriter := csv.NewWriter(contactsFile)
+	if err := csvWriter.Write(header); err != nil {
+		return err
+	}
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read a contact row: %v", err)
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return err
+	}
+	_, err := contactsFile.Seek(0, io.SeekStart)
+	return err
+}
+
+// waitForContactsImport polls the import status of the contact list until the import has completed or failed. When
+// the contact list was imported before, a completed or failed status is only taken for the one of the new import once
+// the import was seen in progress or contactsImportStartGracePeriod has passed.
+func waitForContactsImport(ctx context.Context, proxy *outboundContactListProxy, contactListId string, hasPreviousImport bool) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, contactsImportTimeout)
+	defer cancel()
+
+	started := !hasPreviousImport
+	startDeadline := time.Now().Add(contactsImportStartGracePeriod)
+	for {
+		// The import is started asynchronously once the file is uploaded
+		select {
+		case <-ctx.Done():
+			return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Timed out waiting for the contacts import of Outbound Contact List %s", contactListId), ctx.Err())
+		case <-time.After(contactsImportPollInterval):
+		}
+
+		importStatus, resp, err := proxy.getContactListImportStatus(ctx, contactListId)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to get the import status of Outbound Contact List %s error: %s", contactListId, err), resp)
+		}
+		if importStatus.State == nil {
+			continue
+		}
+
+		state := *importStatus.State
+		if state != "COMPLETED" && state != "FAILED" {
+			started = true
+			if importStatus.PercentComplete != nil {
+				log.Printf("Importing contacts into Outbound Contact List %s: %d%% complete", contactListId, *importStatus.PercentComplete)
+			}
+			continue
+		}
+		if !started && time.Now().Before(startDeadline) {
+			continue
+		}
+
+		if state == "FAILED" {
+			reason := "unknown reason"
+			if importStatus.FailureReason != nil {
+				reason = *importStatus.FailureReason
+			}
+			return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Failed to import contacts into Outbound Contact List %s", contactListId), errors.New(reason))
+		}
+		return nil
+	}
+}
+
+// hasContactsFileChange reports whether the contacts file or the way it is imported has changed since the last import
+func hasContactsFileChange(d *schema.ResourceData) bool {
+	return d.HasChanges("contacts_filepath", "contacts_file_content_hash", "contacts_id_name", "contacts_column_mapping")
+}
+
+func setContactsFileContentHashToNil(d *schema.ResourceData) {
+	_ = d.Set("contacts_file_content_hash", nil)
+}
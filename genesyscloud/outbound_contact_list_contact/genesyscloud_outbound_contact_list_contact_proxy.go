@@ -3,11 +3,15 @@ package outbound_contact_list_contact
 import (
 	"context"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 )
 
 var internalProxy *contactProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[contactProxy]()
+
 type createContactFunc func(ctx context.Context, p *contactProxy, contactListId string, contact platformclientv2.Writabledialercontact, priority, clearSystemData, doNotQueue bool) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error)
 type readContactByIdFunc func(ctx context.Context, p *contactProxy, contactListId, contactId string) (*platformclientv2.Dialercontact, *platformclientv2.APIResponse, error)
 type updateContactFunc func(ctx context.Context, p *contactProxy, contactListId string, contactId string, contact platformclientv2.Dialercontact) (*platformclientv2.Dialercontact, *platformclientv2.APIResponse, error)
@@ -27,7 +31,7 @@ type contactProxy struct {
 
 func newContactProxy(clientConfig *platformclientv2.Configuration) *contactProxy {
 	api := platformclientv2.NewOutboundApiWithConfig(clientConfig)
-	contactCache := rc.NewResourceCache[platformclientv2.Dialercontact]()
+	contactCache := rc.NewClientResourceCache[platformclientv2.Dialercontact](clientConfig)
 	return &contactProxy{
 		clientConfig:        clientConfig,
 		outboundApi:         api,
@@ -41,11 +45,10 @@ func newContactProxy(clientConfig *platformclientv2.Configuration) *contactProxy
 }
 
 func getContactProxy(clientConfig *platformclientv2.Configuration) *contactProxy {
-	if internalProxy == nil {
-		internalProxy = newContactProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newContactProxy)
 }
 
 func (p *contactProxy) createContact(ctx context.Context, contactListId string, contact platformclientv2.Writabledialercontact, priority, clearSystemData, doNotQueue bool) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
@@ -88,6 +91,10 @@ func deleteContactFn(_ context.Context, p *contactProxy, contactListId, contactI
 }
 
 func getAllContactsFn(ctx context.Context, p *contactProxy) ([]platformclientv2.Dialercontact, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.contactCache); cached != nil {
+		return *cached, nil, nil
+	}
+
 	var allContacts []platformclientv2.Dialercontact
 
 	contactListIds, resp, err := p.getAllContactListIds(ctx)
@@ -106,6 +113,7 @@ func getAllContactsFn(ctx context.Context, p *contactProxy) ([]platformclientv2.
 	for _, contact := range allContacts {
 		rc.SetCache(p.contactCache, *contact.Id, contact)
 	}
+	rc.SetCacheComplete(p.contactCache)
 
 	return allContacts, nil, nil
 }
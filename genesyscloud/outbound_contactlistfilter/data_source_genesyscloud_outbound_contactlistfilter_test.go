@@ -3,8 +3,8 @@ package outbound_contactlistfilter
 import (
 	"fmt"
 	"strconv"
-	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/cassette"
 	"testing"
 
 	obContactList "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list"
@@ -27,7 +27,7 @@ func TestAccDataSourceOutboundContactListFilter(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
-		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		ProviderFactories: cassette.ProviderFactories(t, providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			{
 				Config: obContactList.GenerateOutboundContactList(
@@ -6,11 +6,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 	"log"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 )
 
 var internalProxy *outboundDnclistProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[outboundDnclistProxy]()
+
 // type definitions for each func on our proxy
 type createOutboundDnclistFunc func(ctx context.Context, p *outboundDnclistProxy, dnclist *platformclientv2.Dnclistcreate) (*platformclientv2.Dnclist, *platformclientv2.APIResponse, error)
 type getAllOutboundDnclistFunc func(ctx context.Context, p *outboundDnclistProxy) (*[]platformclientv2.Dnclist, *platformclientv2.APIResponse, error)
@@ -19,6 +24,7 @@ type getOutboundDnclistByNameFunc func(ctx context.Context, p *outboundDnclistPr
 type updateOutboundDnclistFunc func(ctx context.Context, p *outboundDnclistProxy, dnclistId string, dnclist *platformclientv2.Dnclist) (*platformclientv2.Dnclist, *platformclientv2.APIResponse, error)
 type deleteOutboundDnclistFunc func(ctx context.Context, p *outboundDnclistProxy, dnclistId string) (*platformclientv2.APIResponse, error)
 type uploadPhoneEntriesToDncListFunc func(p *outboundDnclistProxy, dncList *platformclientv2.Dnclist, entry interface{}) (*platformclientv2.APIResponse, diag.Diagnostics)
+type patchOutboundDnclistPhoneNumbersFunc func(ctx context.Context, p *outboundDnclistProxy, dnclistId string, action string, phoneNumbers []string, expirationDate string) (*platformclientv2.APIResponse, error)
 
 // outboundDnclistProxy contains all the methods that call genesys cloud APIs
 type outboundDnclistProxy struct {
@@ -31,6 +37,8 @@ type outboundDnclistProxy struct {
 	updateOutboundDnclistAttr       updateOutboundDnclistFunc
 	deleteOutboundDnclistAttr       deleteOutboundDnclistFunc
 	uploadPhoneEntriesToDncListAttr uploadPhoneEntriesToDncListFunc
+
+	patchOutboundDnclistPhoneNumbersAttr patchOutboundDnclistPhoneNumbersFunc
 }
 
 // newOutboundDnclistProxy initializes the dnclist proxy with the data needed for communication with the genesys cloud
@@ -46,14 +54,16 @@ func newOutboundDnclistProxy(clientConfig *platformclientv2.Configuration) *outb
 		updateOutboundDnclistAttr:       updateOutboundDnclistFn,
 		deleteOutboundDnclistAttr:       deleteOutboundDnclistFn,
 		uploadPhoneEntriesToDncListAttr: uploadPhoneEntriesToDncListFn,
+
+		patchOutboundDnclistPhoneNumbersAttr: patchOutboundDnclistPhoneNumbersFn,
 	}
 }
 
 func getOutboundDnclistProxy(clientConfig *platformclientv2.Configuration) *outboundDnclistProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundDnclistProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newOutboundDnclistProxy)
 }
 
 // createOutboundDnclist creates a Genesys Cloud Outbound Dnclist
@@ -90,6 +100,11 @@ func (p *outboundDnclistProxy) uploadPhoneEntriesToDncList(dncList *platformclie
 	return p.uploadPhoneEntriesToDncListAttr(p, dncList, entry)
 }
 
+// patchOutboundDnclistPhoneNumbers adds phone numbers to or removes phone numbers from a Genesys Cloud Outbound Dnclist
+func (p *outboundDnclistProxy) patchOutboundDnclistPhoneNumbers(ctx context.Context, dnclistId string, action string, phoneNumbers []string, expirationDate string) (*platformclientv2.APIResponse, error) {
+	return p.patchOutboundDnclistPhoneNumbersAttr(ctx, p, dnclistId, action, phoneNumbers, expirationDate)
+}
+
 func createOutboundDnclistFn(ctx context.Context, p *outboundDnclistProxy, dnclist *platformclientv2.Dnclistcreate) (*platformclientv2.Dnclist, *platformclientv2.APIResponse, error) {
 	list, resp, err := p.outboundApi.PostOutboundDnclists(*dnclist)
 	if err != nil {
@@ -168,6 +183,22 @@ func uploadPhoneEntriesToDncListFn(p *outboundDnclistProxy, dncList *platformcli
 	return resp, nil
 }
 
+func patchOutboundDnclistPhoneNumbersFn(ctx context.Context, p *outboundDnclistProxy, dnclistId string, action string, phoneNumbers []string, expirationDate string) (*platformclientv2.APIResponse, error) {
+	body := platformclientv2.Dncpatchphonenumbersrequest{
+		Action:       &action,
+		PhoneNumbers: &phoneNumbers,
+	}
+	if expirationDate != "" {
+		body.ExpirationDateTime = &expirationDate
+	}
+	// PATCH /api/v2/outbound/dnclists/{dncListId}/phonenumbers
+	resp, err := p.outboundApi.PatchOutboundDnclistPhonenumbers(dnclistId, body)
+	if err != nil {
+		return resp, fmt.Errorf("failed to %s phone numbers of dnc list %s: %s", strings.ToLower(action), dnclistId, err)
+	}
+	return resp, nil
+}
+
 func deleteOutboundDnclistFn(ctx context.Context, p *outboundDnclistProxy, dnclistId string) (*platformclientv2.APIResponse, error) {
 	resp, err := p.outboundApi.DeleteOutboundDnclist(dnclistId)
 	if err != nil {
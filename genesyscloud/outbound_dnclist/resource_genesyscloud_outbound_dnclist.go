@@ -92,8 +92,19 @@ func createOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 			return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Phone numbers can only be uploaded to internal DNC lists."), fmt.Errorf("phone numbers can only be uploaded to internal DNC Lists"))
 		}
 	}
+
+	var diagErr diag.Diagnostics
+	if d.Get("entries_filepath").(string) != "" {
+		if *sdkDncListCreate.DncSourceType != "rds" {
+			return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Phone numbers can only be uploaded to internal DNC lists."), fmt.Errorf("phone numbers can only be uploaded to internal DNC Lists"))
+		}
+		diagErr = syncOutboundDncListEntriesFile(ctx, d, meta)
+		if diagErr.HasError() {
+			return diagErr
+		}
+	}
 	log.Printf("Created Outbound DNC list %s %s", name, *outboundDncList.Id)
-	return readOutboundDncList(ctx, d, meta)
+	return append(diagErr, readOutboundDncList(ctx, d, meta)...)
 }
 
 func updateOutboundDncList(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -162,8 +173,22 @@ func updateOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 		return diagErr
 	}
 
+	if d.Get("entries_filepath").(string) != "" {
+		if d.HasChanges("entries_filepath", "entries_file_content_hash", "entries_manifest_filepath") {
+			if dncSourceType != "rds" {
+				return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Phone numbers can only be uploaded to internal DNC lists"), fmt.Errorf("phone numbers can only be uploaded to internal DNC lists"))
+			}
+			diagErr = syncOutboundDncListEntriesFile(ctx, d, meta)
+			if diagErr.HasError() {
+				return diagErr
+			}
+		}
+	} else {
+		_ = d.Set("entries_digest", "")
+	}
+
 	log.Printf("Updated Outbound DNC list %s", name)
-	return readOutboundDncList(ctx, d, meta)
+	return append(diagErr, readOutboundDncList(ctx, d, meta)...)
 }
 
 func readOutboundDncList(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1,6 +1,8 @@
 package outbound_dnclist
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
@@ -30,6 +32,9 @@ func ResourceOutboundDncList() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customdiff.ComputedIf("entries_digest", func(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+			return d.HasChanges("entries_filepath", "entries_file_content_hash")
+		}),
 		Schema: map[string]*schema.Schema{
 			`name`: {
 				Description: `The name of the DncList.`,
@@ -81,9 +86,10 @@ func ResourceOutboundDncList() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{`rds`, `dnc.com`, `gryphon`}, false),
 			},
 			`entries`: {
-				Description: `Rows to add to the DNC list. To emulate removing phone numbers, you can set expiration_date to a date in the past.`,
-				Optional:    true,
-				Type:        schema.TypeList,
+				Description:   `Rows to add to the DNC list. To emulate removing phone numbers, you can set expiration_date to a date in the past.`,
+				Optional:      true,
+				Type:          schema.TypeList,
+				ConflictsWith: []string{"entries_filepath"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						`expiration_date`: {
@@ -104,6 +110,30 @@ func ResourceOutboundDncList() *schema.Resource {
 					},
 				},
 			},
+			`entries_filepath`: {
+				Description:   `Path or URL of a CSV file of the phone numbers of the DNC list. The header row must have a phone_number column and may have an expiration_date column in yyyy-MM-ddTHH:mmZ format. Only possible if the dncSourceType is rds. When the file changes, phone numbers added to the file are added to the DNC list and phone numbers removed from the file are removed from it.`,
+				Optional:      true,
+				Type:          schema.TypeString,
+				ValidateFunc:  validators.ValidatePath,
+				RequiredWith:  []string{"entries_file_content_hash"},
+				ConflictsWith: []string{"entries"},
+			},
+			`entries_file_content_hash`: {
+				Description:  `Hash value of the entries file content, e.g. filesha256(entries_filepath). Used to detect changes.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				RequiredWith: []string{"entries_filepath"},
+			},
+			`entries_manifest_filepath`: {
+				Description: `Path of the file keeping the entries of the last apply, used to find the phone numbers removed from the entries file. Defaults to entries_filepath with a .manifest suffix, and must be set when entries_filepath is a URL. The file should be kept with the configuration, phone numbers cannot be removed when it is missing.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`entries_digest`: {
+				Description: `SHA-256 digest of the entries applied from entries_filepath.`,
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
 		},
 	}
 }
@@ -0,0 +1,161 @@
+package outbound_dnclist
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+type dncPatchCall struct {
+	action         string
+	phoneNumbers   []string
+	expirationDate string
+}
+
+func TestUnitResourceOutboundDncListSyncEntriesFile(t *testing.T) {
+	tId := uuid.NewString()
+	dir := t.TempDir()
+	entriesFilePath := filepath.Join(dir, "dnc.csv")
+
+	var calls []dncPatchCall
+	dncListProxy := &outboundDnclistProxy{}
+	dncListProxy.patchOutboundDnclistPhoneNumbersAttr = func(ctx context.Context, p *outboundDnclistProxy, dnclistId string, action string, phoneNumbers []string, expirationDate string) (*platformclientv2.APIResponse, error) {
+		assert.Equal(t, tId, dnclistId)
+		calls = append(calls, dncPatchCall{action, phoneNumbers, expirationDate})
+		return nil, nil
+	}
+	internalProxy = dncListProxy
+	defer func() { internalProxy = nil }()
+
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	resourceSchema := ResourceOutboundDncList().Schema
+
+	// The first apply adds all the numbers
+	assert.Nil(t, os.WriteFile(entriesFilePath, []byte("phone_number,expiration_date\n+13175550100,\n+13175550101,2030-01-01T00:00Z\n+13175550102,\n"), 0644))
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"entries_filepath":          entriesFilePath,
+		"entries_file_content_hash": "hash1",
+	})
+	d.SetId(tId)
+	diagErr := syncOutboundDncListEntriesFile(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError())
+	assert.Equal(t, []dncPatchCall{
+		{"Add", []string{"+13175550100", "+13175550102"}, ""},
+		{"Add", []string{"+13175550101"}, "2030-01-01T00:00Z"},
+	}, calls)
+	digest := d.Get("entries_digest").(string)
+	assert.NotEmpty(t, digest)
+	assert.FileExists(t, entriesFilePath+".manifest")
+
+	// The next apply only sends the difference
+	calls = nil
+	assert.Nil(t, os.WriteFile(entriesFilePath, []byte("phone_number,expiration_date\n+13175550100,2031-01-01T00:00Z\n+13175550101,2030-01-01T00:00Z\n+13175550103,\n"), 0644))
+	d = dncListResourceDataWithDigest(t, tId, digest)
+	assert.Nil(t, d.Set("entries_filepath", entriesFilePath))
+	assert.Nil(t, d.Set("entries_file_content_hash", "hash2"))
+	diagErr = syncOutboundDncListEntriesFile(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError())
+	assert.Empty(t, diagErr)
+	assert.Equal(t, []dncPatchCall{
+		{"Remove", []string{"+13175550102"}, ""},
+		{"Add", []string{"+13175550103"}, ""},
+		{"Add", []string{"+13175550100"}, "2031-01-01T00:00Z"},
+	}, calls)
+	assert.NotEqual(t, digest, d.Get("entries_digest"))
+
+	// Numbers cannot be removed without the manifest of the last apply
+	calls = nil
+	assert.Nil(t, os.Remove(entriesFilePath+".manifest"))
+	assert.Nil(t, os.WriteFile(entriesFilePath, []byte("phone_number\n+13175550104\n"), 0644))
+	d = dncListResourceDataWithDigest(t, tId, digest)
+	assert.Nil(t, d.Set("entries_filepath", entriesFilePath))
+	assert.Nil(t, d.Set("entries_file_content_hash", "hash3"))
+	diagErr = syncOutboundDncListEntriesFile(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError())
+	assert.Len(t, diagErr, 1)
+	assert.Equal(t, []dncPatchCall{{"Add", []string{"+13175550104"}, ""}}, calls)
+}
+
+// dncListResourceDataWithDigest returns the resource data of a DNC list with the entries digest of a previous apply in its state
+func dncListResourceDataWithDigest(t *testing.T, id string, digest string) *schema.ResourceData {
+	d := ResourceOutboundDncList().Data(&terraform.InstanceState{ID: id, Attributes: map[string]string{"entries_digest": digest}})
+	assert.Equal(t, id, d.Id())
+	return d
+}
+
+func TestUnitResourceOutboundDncListSyncEntriesFileBatches(t *testing.T) {
+	tId := uuid.NewString()
+	dir := t.TempDir()
+	entriesFilePath := filepath.Join(dir, "dnc.csv")
+	contents := "phone_number\n"
+	for i := 0; i < 2500; i++ {
+		contents += fmt.Sprintf("+1317555%04d\n", i)
+	}
+	assert.Nil(t, os.WriteFile(entriesFilePath, []byte(contents), 0644))
+
+	var batchSizes []int
+	dncListProxy := &outboundDnclistProxy{}
+	dncListProxy.patchOutboundDnclistPhoneNumbersAttr = func(ctx context.Context, p *outboundDnclistProxy, dnclistId string, action string, phoneNumbers []string, expirationDate string) (*platformclientv2.APIResponse, error) {
+		batchSizes = append(batchSizes, len(phoneNumbers))
+		return nil, nil
+	}
+	internalProxy = dncListProxy
+	defer func() { internalProxy = nil }()
+
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	d := schema.TestResourceDataRaw(t, ResourceOutboundDncList().Schema, map[string]interface{}{
+		"entries_filepath":          entriesFilePath,
+		"entries_file_content_hash": "hash",
+		"entries_manifest_filepath": filepath.Join(dir, "dnc_manifest.csv"),
+	})
+	d.SetId(tId)
+	diagErr := syncOutboundDncListEntriesFile(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError())
+	assert.Equal(t, []int{1000, 1000, 500}, batchSizes)
+	assert.FileExists(t, filepath.Join(dir, "dnc_manifest.csv"))
+}
+
+func TestUnitResourceOutboundDncListReadEntriesFile(t *testing.T) {
+	dir := t.TempDir()
+	testCases := map[string]string{
+		"missing phone_number column":           "number\n+13175550100\n",
+		"Failed to parse number":                "phone_number\n3175550100\n",
+		"Failed to parse date":                  "phone_number,expiration_date\n+13175550100,2030-01-01\n",
+		"listed with different expiration date": "phone_number,expiration_date\n+13175550100,\n+13175550100,2030-01-01T00:00Z\n",
+	}
+	for expectedErr, contents := range testCases {
+		entriesFilePath := filepath.Join(dir, "dnc.csv")
+		assert.Nil(t, os.WriteFile(entriesFilePath, []byte(contents), 0644))
+		_, err := readDncEntriesFile(entriesFilePath)
+		assert.ErrorContains(t, err, expectedErr)
+	}
+}
+
+func TestUnitResourceOutboundDncListEntriesDigestDiff(t *testing.T) {
+	resource := ResourceOutboundDncList()
+	state := &terraform.InstanceState{ID: uuid.NewString(), Attributes: map[string]string{
+		"name":                      "dnc",
+		"dnc_source_type":           "rds",
+		"entries_filepath":          "dnc.csv",
+		"entries_file_content_hash": "hash1",
+		"entries_digest":            "digest",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                      "dnc",
+		"dnc_source_type":           "rds",
+		"entries_filepath":          "dnc.csv",
+		"entries_file_content_hash": "hash2",
+	})
+	diff, err := resource.SimpleDiff(context.Background(), state, config, nil)
+	assert.Nil(t, err)
+	assert.True(t, diff.Attributes["entries_digest"].NewComputed)
+}
@@ -0,0 +1,288 @@
+package outbound_dnclist
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+const (
+	// Maximum number of phone numbers in a single add or remove request
+	dncPhoneNumbersBatchSize = 1000
+
+	dncPhoneNumberColumn    = "phone_number"
+	dncExpirationDateColumn = "expiration_date"
+)
+
+// dncEntries maps the phone numbers of a DNC list to their expiration date, empty if they don't expire
+type dncEntries map[string]string
+
+// syncOutboundDncListEntriesFile applies the entries of entries_filepath to the DNC list. Only the difference with the
+// entries applied before, kept in the manifest file, is sent: new numbers and numbers with a new expiration date are
+// added and numbers no longer in the file are removed. The digest of the applied entries is stored in the state.
+func syncOutboundDncListEntriesFile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filePath := d.Get("entries_filepath").(string)
+	manifestPath, err := getEntriesManifestPath(d)
+	if err != nil {
+		setEntriesFileContentHashToNil(d)
+		return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Failed to sync the entries of Outbound DNC list %s", d.Id()), err)
+	}
+
+	entries, err := readDncEntriesFile(filePath)
+	if err != nil {
+		setEntriesFileContentHashToNil(d)
+		return util.BuildDiagnosticError(resourceName, fmt.Sprintf("Failed to read the entries file %s of Outbound DNC list %s", filePath, d.Id()), err)
+	}
+
+	var diagErr diag.Diagnostics
+	previousEntries := make(dncEntries)
+	// The digest is recomputed when the file changes, so the planned value is unknown
+	if previousDigest, _ := d.GetChange("entries_digest"); previousDigest.(string) != "" {
+		manifestEntries, digest, err := readDncEntriesManifest(manifestPath)
+		if err == nil && digest == previousDigest.(string) {
+			previousEntries = manifestEntries
+		} else {
+			// Without the entries applied before, numbers removed from the file cannot be removed from the list
+			diagErr = append(diagErr, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Entries manifest %s of Outbound DNC list %s is missing or out of date", manifestPath, d.Id()),
+				Detail:   "Only the entries of the file are added, phone numbers removed from the file since the last apply stay in the DNC list.",
+			})
+		}
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getOutboundDnclistProxy(sdkConfig)
+
+	added, removed := diffDncEntries(previousEntries, entries)
+	addedCount := 0
+	for _, numbers := range added {
+		addedCount += len(numbers)
+	}
+	log.Printf("Syncing entries of Outbound DNC list %s: %d phone numbers to add, %d to remove", d.Id(), addedCount, len(removed))
+	if resp, err := patchDncPhoneNumbers(ctx, proxy, d.Id(), "Remove", removed, ""); err != nil {
+		setEntriesFileContentHashToNil(d)
+		return append(diagErr, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to remove phone numbers from Outbound DNC list %s error: %s", d.Id(), err), resp)...)
+	}
+	for _, expirationDate := range sortedKeys(added) {
+		if resp, err := patchDncPhoneNumbers(ctx, proxy, d.Id(), "Add", added[expirationDate], expirationDate); err != nil {
+			setEntriesFileContentHashToNil(d)
+			return append(diagErr, util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to add phone numbers to Outbound DNC list %s error: %s", d.Id(), err), resp)...)
+		}
+	}
+
+	digest, err := writeDncEntriesManifest(manifestPath, entries)
+	if err != nil {
+		setEntriesFileContentHashToNil(d)
+		return append(diagErr, util.BuildDiagnosticError(resourceName, fmt.Sprintf("Failed to write the entries manifest %s of Outbound DNC list %s", manifestPath, d.Id()), err)...)
+	}
+	_ = d.Set("entries_digest", digest)
+	log.Printf("Synced entries of Outbound DNC list %s", d.Id())
+	return diagErr
+}
+
+// patchDncPhoneNumbers adds or removes phone numbers in batches
+func patchDncPhoneNumbers(ctx context.Context, proxy *outboundDnclistProxy, dnclistId string, action string, phoneNumbers []string, expirationDate string) (*platformclientv2.APIResponse, error) {
+	if len(phoneNumbers) == 0 {
+		return nil, nil
+	}
+	for _, batch := range chunks.ChunkBy(phoneNumbers, dncPhoneNumbersBatchSize) {
+		resp, err := proxy.patchOutboundDnclistPhoneNumbers(ctx, dnclistId, action, batch, expirationDate)
+		if err != nil {
+			return resp, err
+		}
+	}
+	return nil, nil
+}
+
+// diffDncEntries returns the phone numbers to add, grouped by expiration date, and the phone numbers to remove
+func diffDncEntries(previous, current dncEntries) (added map[string][]string, removed []string) {
+	added = make(map[string][]string)
+	for number, expirationDate := range current {
+		if previousExpirationDate, ok := previous[number]; !ok || previousExpirationDate != expirationDate {
+			added[expirationDate] = append(added[expirationDate], number)
+		}
+	}
+	for number := range previous {
+		if _, ok := current[number]; !ok {
+			removed = append(removed, number)
+		}
+	}
+	for _, numbers := range added {
+		sort.Strings(numbers)
+	}
+	sort.Strings(removed)
+	return added, removed
+}
+
+// readDncEntriesFile reads a CSV file of DNC entries. The header row must have a phone_number column and may have an
+// expiration_date column.
+func readDncEntriesFile(filePath string) (dncEntries, error) {
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	} else if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+	return parseDncEntries(reader, true)
+}
+
+// parseDncEntries parses DNC entries in CSV format, validating the phone numbers and expiration dates when validate is set
+func parseDncEntries(reader io.Reader, validate bool) (dncEntries, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("missing header row with a %s column", dncPhoneNumberColumn)
+	}
+	if err != nil {
+		return nil, err
+	}
+	phoneNumberIndex, expirationDateIndex := -1, -1
+	for i, column := range header {
+		switch strings.TrimSpace(column) {
+		case dncPhoneNumberColumn:
+			phoneNumberIndex = i
+		case dncExpirationDateColumn:
+			expirationDateIndex = i
+		}
+	}
+	if phoneNumberIndex == -1 {
+		return nil, fmt.Errorf("missing %s column in the header row", dncPhoneNumberColumn)
+	}
+
+	entries := make(dncEntries)
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := csvReader.FieldPos(0)
+		if phoneNumberIndex >= len(record) || strings.TrimSpace(record[phoneNumberIndex]) == "" {
+			return nil, fmt.Errorf("line %d: missing phone number", line)
+		}
+		phoneNumber := strings.TrimSpace(record[phoneNumberIndex])
+		var expirationDate string
+		if expirationDateIndex != -1 && expirationDateIndex < len(record) {
+			expirationDate = strings.TrimSpace(record[expirationDateIndex])
+		}
+
+		if validate {
+			if diagErr := validators.ValidatePhoneNumber(phoneNumber, nil); diagErr.HasError() {
+				return nil, fmt.Errorf("line %d: %s", line, diagErr[0].Summary)
+			}
+			if expirationDate != "" {
+				if diagErr := validators.ValidateDateTime(expirationDate, nil); diagErr.HasError() {
+					return nil, fmt.Errorf("line %d: %s", line, diagErr[0].Summary)
+				}
+			}
+			if previousExpirationDate, ok := entries[phoneNumber]; ok && previousExpirationDate != expirationDate {
+				return nil, fmt.Errorf("line %d: phone number %s is listed with different expiration dates", line, phoneNumber)
+			}
+		}
+		entries[phoneNumber] = expirationDate
+	}
+}
+
+// formatDncEntries formats DNC entries in CSV format, sorted by phone number so that the same entries always have the same digest
+func formatDncEntries(entries dncEntries) ([]byte, error) {
+	builder := &strings.Builder{}
+	csvWriter := csv.NewWriter(builder)
+	if err := csvWriter.Write([]string{dncPhoneNumberColumn, dncExpirationDateColumn}); err != nil {
+		return nil, err
+	}
+	for _, phoneNumber := range sortedKeys(entries) {
+		if err := csvWriter.Write([]string{phoneNumber, entries[phoneNumber]}); err != nil {
+			return nil, err
+		}
+	}
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return nil, err
+	}
+	return []byte(builder.String()), nil
+}
+
+// readDncEntriesManifest reads the entries applied by the last apply, and the digest of the manifest file
+func readDncEntriesManifest(manifestPath string) (dncEntries, string, error) {
+	contents, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, "", err
+	}
+	entries, err := parseDncEntries(strings.NewReader(string(contents)), false)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse entries manifest %s: %v", manifestPath, err)
+	}
+	return entries, digestDncEntries(contents), nil
+}
+
+// writeDncEntriesManifest writes the applied entries to the manifest file and returns their digest
+func writeDncEntriesManifest(manifestPath string, entries dncEntries) (string, error) {
+	contents, err := formatDncEntries(entries)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(manifestPath, contents, 0644); err != nil {
+		return "", err
+	}
+	return digestDncEntries(contents), nil
+}
+
+func digestDncEntries(contents []byte) string {
+	hash := sha256.Sum256(contents)
+	return hex.EncodeToString(hash[:])
+}
+
+// getEntriesManifestPath returns entries_manifest_filepath, or the entries file path with a .manifest suffix when it is not set
+func getEntriesManifestPath(d *schema.ResourceData) (string, error) {
+	if manifestPath := d.Get("entries_manifest_filepath").(string); manifestPath != "" {
+		return manifestPath, nil
+	}
+	filePath := d.Get("entries_filepath").(string)
+	if _, err := os.Stat(filePath); errors.Is(err, fs.ErrNotExist) {
+		if _, err := url.ParseRequestURI(filePath); err == nil {
+			return "", fmt.Errorf("entries_manifest_filepath must be set when entries_filepath is a URL")
+		}
+	}
+	return filePath + ".manifest", nil
+}
+
+func setEntriesFileContentHashToNil(d *schema.ResourceData) {
+	_ = d.Set("entries_file_content_hash", nil)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -17,6 +18,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *outboundFilespecificationtemplateProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[outboundFilespecificationtemplateProxy]()
+
 // Type definitions for each func on our proxy, so we can easily mock them out later
 type createOutboundFilespecificationtemplateFunc func(ctx context.Context, p *outboundFilespecificationtemplateProxy, fileSpecificationTemplate *platformclientv2.Filespecificationtemplate) (*platformclientv2.Filespecificationtemplate, *platformclientv2.APIResponse, error)
 type getAllOutboundFilespecificationtemplateFunc func(ctx context.Context, p *outboundFilespecificationtemplateProxy, name string) (*[]platformclientv2.Filespecificationtemplate, *platformclientv2.APIResponse, error)
@@ -53,14 +57,13 @@ func newOutboundFilespecificationtemplateProxy(clientConfig *platformclientv2.Co
 	}
 }
 
-// getOutboundFilespecificationtemplateProxy acts as a singleton to for the internalProxy. It also ensures
+// getOutboundFilespecificationtemplateProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundFilespecificationtemplateProxy(clientConfig *platformclientv2.Configuration) *outboundFilespecificationtemplateProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundFilespecificationtemplateProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newOutboundFilespecificationtemplateProxy)
 }
 
 // createOutboundFilespecificationtemplate creates a Genesys Cloud outbound filespecificationtemplate
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -18,6 +19,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *outboundRulesetProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[outboundRulesetProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createOutboundRulesetFunc func(ctx context.Context, p *outboundRulesetProxy, ruleset *platformclientv2.Ruleset) (*platformclientv2.Ruleset, *platformclientv2.APIResponse, error)
 type getAllOutboundRulesetFunc func(ctx context.Context, p *outboundRulesetProxy) (*[]platformclientv2.Ruleset, *platformclientv2.APIResponse, error)
@@ -53,13 +57,13 @@ func newOutboundRulesetProxy(clientConfig *platformclientv2.Configuration) *outb
 	}
 }
 
-// getOutboundRulesetProxy acts as a singleton to for the internalProxy.  It also ensures
+// getOutboundRulesetProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getOutboundRulesetProxy(clientConfig *platformclientv2.Configuration) *outboundRulesetProxy {
-	if internalProxy == nil {
-		internalProxy = newOutboundRulesetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newOutboundRulesetProxy)
 }
 
 // createOutboundRuleset creates a Genesys Cloud Outbound Ruleset
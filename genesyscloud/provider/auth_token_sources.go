@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+const (
+	assertionGrantTypeSaml2Bearer = "saml2_bearer"
+	assertionGrantTypeJwtBearer   = "jwt_bearer"
+
+	// Refresh tokens this long before they expire
+	tokenRefreshMargin = time.Minute
+	// Wait this long before trying again when a refresh fails
+	tokenRefreshRetryInterval = 30 * time.Second
+	// Kill the token command if it hasn't printed a token after this long
+	tokenCommandTimeout = 30 * time.Second
+)
+
+var assertionGrantTypes = map[string]string{
+	assertionGrantTypeSaml2Bearer: "urn:ietf:params:oauth:grant-type:saml2-bearer",
+	assertionGrantTypeJwtBearer:   "urn:ietf:params:oauth:grant-type:jwt-bearer",
+}
+
+/*
+A tokenSource is an alternative to client credentials for obtaining an access token. InitClientConfig asks the
+configured source for a token for every client config (including every config pre-filled into an SDKClientPool)
+and gives that config a tokenRefresher, which fetches a new token before a request is sent once the current one
+is about to expire.
+*/
+type tokenSource interface {
+	// description is used in log and error messages
+	description() string
+	// token returns an access token and how long it is valid for. A zero duration means the source doesn't know
+	// when the token expires, in which case it is fetched again after the configured refresh interval.
+	token(ctx context.Context, config *platformclientv2.Configuration) (string, time.Duration, error)
+}
+
+// fileTokenSource reads an access token from a file that is kept up to date by an external agent
+type fileTokenSource struct {
+	path string
+}
+
+func (s *fileTokenSource) description() string {
+	return fmt.Sprintf("token file %s", s.path)
+}
+
+func (s *fileTokenSource) token(_ context.Context, _ *platformclientv2.Configuration) (string, time.Duration, error) {
+	contents, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read token file %s: %v", s.path, err)
+	}
+	accessToken := strings.TrimSpace(string(contents))
+	if accessToken == "" {
+		return "", 0, fmt.Errorf("token file %s is empty", s.path)
+	}
+	return accessToken, 0, nil
+}
+
+// commandTokenSource runs an external credential helper that prints a token response as JSON on stdout,
+// e.g. {"access_token": "...", "expires_in": 86400}
+type commandTokenSource struct {
+	command []string
+}
+
+func (s *commandTokenSource) description() string {
+	return fmt.Sprintf("token command %s", s.command[0])
+}
+
+func (s *commandTokenSource) token(ctx context.Context, _ *platformclientv2.Configuration) (string, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, tokenCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.command[0], s.command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return "", 0, fmt.Errorf("token command %s did not complete: %v", s.command[0], ctx.Err())
+		}
+		return "", 0, fmt.Errorf("token command %s failed: %v %s", s.command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	var authResponse platformclientv2.AuthResponse
+	if err := json.Unmarshal(stdout.Bytes(), &authResponse); err != nil {
+		return "", 0, fmt.Errorf("failed to parse output of token command %s: %v", s.command[0], err)
+	}
+	if authResponse.AccessToken == "" {
+		return "", 0, fmt.Errorf("token command %s did not return an access_token", s.command[0])
+	}
+	return authResponse.AccessToken, time.Duration(authResponse.ExpiresIn) * time.Second, nil
+}
+
+// assertionTokenSource exchanges a SAML2 or JWT assertion for an access token using an OAuth bearer assertion grant
+type assertionTokenSource struct {
+	grantType     string
+	assertionFile string
+	orgName       string
+	clientID      string
+	clientSecret  string
+	tokenUrl      string
+}
+
+func (s *assertionTokenSource) description() string {
+	return fmt.Sprintf("%s assertion grant", s.grantType)
+}
+
+func (s *assertionTokenSource) token(_ context.Context, config *platformclientv2.Configuration) (string, time.Duration, error) {
+	// The assertion is read on every request as it is usually short-lived and rotated by an identity provider
+	assertion, err := os.ReadFile(s.assertionFile)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read assertion file %s: %v", s.assertionFile, err)
+	}
+
+	headerParams := map[string]string{
+		"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte(s.clientID+":"+s.clientSecret)),
+		"Content-Type":  "application/x-www-form-urlencoded",
+	}
+	formParams := url.Values{}
+	formParams.Set("grant_type", assertionGrantTypes[s.grantType])
+	formParams.Set("assertion", strings.TrimSpace(string(assertion)))
+	if s.orgName != "" {
+		formParams.Set("orgName", s.orgName)
+	}
+
+	// A separate API client as a refresh can happen while a request of the config's own client is being sent
+	apiClient := platformclientv2.NewAPIClient(config)
+	response, err := apiClient.CallAPI(s.getTokenUrl(config), http.MethodPost, nil, headerParams, nil, formParams, "", nil)
+	if err != nil && response == nil {
+		return "", 0, err
+	}
+	if response.StatusCode != http.StatusOK {
+		var authErrorResponse platformclientv2.AuthErrorResponse
+		_ = json.Unmarshal(response.RawBody, &authErrorResponse)
+		return "", 0, fmt.Errorf("Auth Error: %v - %v (%v)", response.StatusCode, authErrorResponse.Error, authErrorResponse.ErrorDescription)
+	}
+
+	var authResponse platformclientv2.AuthResponse
+	if err := json.Unmarshal(response.RawBody, &authResponse); err != nil {
+		return "", 0, err
+	}
+	if authResponse.AccessToken == "" {
+		return "", 0, fmt.Errorf("Auth Error: No access token found")
+	}
+	return authResponse.AccessToken, time.Duration(authResponse.ExpiresIn) * time.Second, nil
+}
+
+// getTokenUrl returns the configured token URL or derives the login host of the region from the config's base path
+func (s *assertionTokenSource) getTokenUrl(config *platformclientv2.Configuration) string {
+	if s.tokenUrl != "" {
+		return s.tokenUrl
+	}
+	authHostRegex := regexp.MustCompile(`(?i)\/\/api\.`)
+	return authHostRegex.ReplaceAllString(config.BasePath, "//login.") + "/oauth/token"
+}
+
+// getTokenSource builds the token source described by the provider's auth block. It returns nil if the provider
+// should fall back to client credentials.
+func getTokenSource(data *schema.ResourceData) (tokenSource, time.Duration, diag.Diagnostics) {
+	authSet, ok := data.Get("auth").(*schema.Set)
+	if !ok || authSet.Len() == 0 {
+		return nil, 0, nil
+	}
+	auth := authSet.List()[0].(map[string]interface{})
+	refreshInterval := time.Duration(auth["token_refresh_interval"].(int)) * time.Second
+
+	var sources []tokenSource
+	if tokenFile := auth["token_file"].(string); tokenFile != "" {
+		sources = append(sources, &fileTokenSource{path: tokenFile})
+	}
+	if commandList, _ := auth["token_command"].([]interface{}); len(commandList) > 0 {
+		command := make([]string, 0, len(commandList))
+		for _, arg := range commandList {
+			command = append(command, arg.(string))
+		}
+		sources = append(sources, &commandTokenSource{command: command})
+	}
+	if assertionFile := auth["assertion_file"].(string); assertionFile != "" {
+		sources = append(sources, &assertionTokenSource{
+			grantType:     auth["assertion_grant_type"].(string),
+			assertionFile: assertionFile,
+			orgName:       auth["org_name"].(string),
+			clientID:      data.Get("oauthclient_id").(string),
+			clientSecret:  data.Get("oauthclient_secret").(string),
+			tokenUrl:      auth["token_url"].(string),
+		})
+	}
+
+	switch len(sources) {
+	case 0:
+		return nil, 0, diag.Errorf("auth block requires one of token_file, token_command or assertion_file")
+	case 1:
+		return sources[0], refreshInterval, nil
+	default:
+		return nil, 0, diag.Errorf("only one of token_file, token_command or assertion_file can be set in the auth block")
+	}
+}
+
+// authorizeWithTokenSource sets the access token of config from source and keeps it refreshed
+func authorizeWithTokenSource(config *platformclientv2.Configuration, source tokenSource, refreshInterval time.Duration) diag.Diagnostics {
+	refresher := &tokenRefresher{
+		source:          source,
+		config:          config,
+		refreshInterval: refreshInterval,
+		now:             time.Now,
+	}
+
+	diagErr := withRetries(context.Background(), time.Minute, func() *retry.RetryError {
+		accessToken, expiresIn, err := refresher.token(context.Background())
+		if err != nil {
+			if !strings.Contains(err.Error(), "Auth Error: 400 - invalid_request (rate limit exceeded;") {
+				return retry.NonRetryableError(fmt.Errorf("failed to authorize Genesys Cloud client with %s: %v", source.description(), err))
+			}
+			return retry.RetryableError(fmt.Errorf("exhausted retries on Genesys Cloud %s. %v", source.description(), err))
+		}
+		// The config isn't shared yet, later tokens are only set on the requests sent with it
+		config.AccessToken = accessToken
+		config.AccessTokenExpiresIn = int(expiresIn.Seconds())
+		return nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	refresher.attach(config)
+	return nil
+}
+
+// tokenRefresher holds the current token of a single client config. Unlike the SDK's refresh of client credentials
+// nothing runs in the background: an expiring token is replaced right before the next request that needs it, and
+// a failed refresh doesn't panic, the current token is used until the source hands out a valid one again.
+type tokenRefresher struct {
+	source          tokenSource
+	config          *platformclientv2.Configuration
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mu          sync.Mutex
+	accessToken string
+	expiresIn   time.Duration
+	refreshAt   time.Time
+	expiresAt   time.Time // zero if the source doesn't know when the token expires
+}
+
+// token returns the current access token, fetching a new one from the source if it is due for a refresh
+func (r *tokenRefresher) token(ctx context.Context) (string, time.Duration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if r.accessToken != "" && now.Before(r.refreshAt) {
+		return r.accessToken, r.expiresIn, nil
+	}
+
+	accessToken, expiresIn, err := r.source.token(ctx, r.config)
+	if err != nil {
+		if r.accessToken == "" || (!r.expiresAt.IsZero() && !now.Before(r.expiresAt)) {
+			return "", 0, err
+		}
+		log.Printf("Failed to refresh access token with %s, retrying in %v: %v", r.source.description(), tokenRefreshRetryInterval, err)
+		r.refreshAt = now.Add(tokenRefreshRetryInterval)
+		return r.accessToken, r.expiresIn, nil
+	}
+
+	if r.accessToken != "" {
+		log.Printf("Refreshed access token with %s", r.source.description())
+	}
+	r.accessToken = accessToken
+	r.expiresIn = expiresIn
+	r.refreshAt = now.Add(nextTokenRefresh(expiresIn, r.refreshInterval))
+	r.expiresAt = time.Time{}
+	if expiresIn > 0 {
+		r.expiresAt = now.Add(expiresIn)
+	}
+	return accessToken, expiresIn, nil
+}
+
+// attach makes every API request sent with config carry the current token. The SDK's own token requests use basic
+// auth and are left alone.
+func (r *tokenRefresher) attach(config *platformclientv2.Configuration) {
+	requestLogHook := config.RetryConfiguration.RequestLogHook
+	config.RetryConfiguration.RequestLogHook = func(request *http.Request, count int) {
+		if request != nil && strings.HasPrefix(request.Header.Get("Authorization"), "Bearer ") {
+			if accessToken, _, err := r.token(request.Context()); err != nil {
+				log.Printf("Failed to refresh access token with %s: %v", r.source.description(), err)
+			} else {
+				request.Header.Set("Authorization", "Bearer "+accessToken)
+			}
+		}
+		if requestLogHook != nil {
+			requestLogHook(request, count)
+		}
+	}
+}
+
+// nextTokenRefresh returns how long to wait before refreshing a token that is valid for expiresIn
+func nextTokenRefresh(expiresIn time.Duration, refreshInterval time.Duration) time.Duration {
+	if expiresIn <= 0 {
+		return refreshInterval
+	}
+	if expiresIn > 2*tokenRefreshMargin {
+		return expiresIn - tokenRefreshMargin
+	}
+	return expiresIn / 2
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+// NewHTTPClient returns an HTTP client for requests made outside of the SDK, such as downloads from pre-signed
+// URLs. It goes through the same proxy as the SDK clients of the provider.
+func NewHTTPClient(config *platformclientv2.Configuration, timeout time.Duration) *http.Client {
+	client := &http.Client{Timeout: timeout}
+	if proxyUrl := getProxyUrl(config.ProxyConfiguration); proxyUrl != nil {
+		client.Transport = &http.Transport{Proxy: http.ProxyURL(proxyUrl)}
+	}
+	return client
+}
+
+// getProxyUrl builds the URL of a proxy configuration the same way as the SDK
+func getProxyUrl(proxyConfig *platformclientv2.ProxyConfiguration) *url.URL {
+	if proxyConfig == nil || proxyConfig.Host == "" {
+		return nil
+	}
+	proxyUrl := &url.URL{
+		Scheme: proxyConfig.Protocol,
+		Host:   proxyConfig.Host + ":" + proxyConfig.Port,
+	}
+	if proxyConfig.Auth != nil && proxyConfig.Auth.UserName != "" && proxyConfig.Auth.Password != "" {
+		proxyUrl.User = url.UserPassword(proxyConfig.Auth.UserName, proxyConfig.Auth.Password)
+	}
+	return proxyUrl
+}
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -36,8 +37,16 @@ func init() {
 
 }
 
+// A BasePathFunc rewrites the API base path of the configured region. Test harnesses use it to send the requests
+// of a provider to a local server, see GetProviderFactoriesWithBasePathFunc.
+type BasePathFunc func(basePath string) string
+
 // New initializes the provider schema
 func New(version string, providerResources map[string]*schema.Resource, providerDataSources map[string]*schema.Resource) func() *schema.Provider {
+	return newProvider(version, providerResources, providerDataSources, nil)
+}
+
+func newProvider(version string, providerResources map[string]*schema.Resource, providerDataSources map[string]*schema.Resource, basePathFunc BasePathFunc) func() *schema.Provider {
 	return func() *schema.Provider {
 
 		/*
@@ -110,6 +119,83 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 					Description:  "Max number of OAuth tokens in the token pool. Can be set with the `GENESYSCLOUD_TOKEN_POOL_SIZE` environment variable.",
 					ValidateFunc: validation.IntBetween(1, 20),
 				},
+				"auth": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Alternative ways of obtaining an access token instead of storing an OAuth client secret. Only one of `token_file`, `token_command` or `assertion_file` can be set.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"token_file": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_TOKEN_FILE", nil),
+								Description: "Path to a file containing an access token that is rotated by an external agent. The file is read again every `token_refresh_interval` seconds. Can be set with the `GENESYSCLOUD_TOKEN_FILE` environment variable.",
+							},
+							"token_command": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Credential helper command and its arguments. The command must print a JSON object with an `access_token` and optionally `expires_in` (in seconds) to stdout. The command is stopped if it does not complete within 30 seconds.",
+							},
+							"assertion_file": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_ASSERTION_FILE", nil),
+								Description: "Path to a file containing a base64 encoded SAML2 or JWT assertion that is exchanged for an access token together with `oauthclient_id` and `oauthclient_secret`. Can be set with the `GENESYSCLOUD_ASSERTION_FILE` environment variable.",
+							},
+							"assertion_grant_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      assertionGrantTypeSaml2Bearer,
+								Description:  "Grant type used to exchange the assertion in `assertion_file`.",
+								ValidateFunc: validation.StringInSlice([]string{assertionGrantTypeSaml2Bearer, assertionGrantTypeJwtBearer}, false),
+							},
+							"org_name": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_ORG_NAME", nil),
+								Description: "Short name of the org the assertion is exchanged for. Required by the SAML2 bearer grant. Can be set with the `GENESYSCLOUD_ORG_NAME` environment variable.",
+							},
+							"token_url": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								DefaultFunc: schema.EnvDefaultFunc("GENESYSCLOUD_TOKEN_URL", nil),
+								Description: "OAuth token endpoint used for the assertion grant. Defaults to the login endpoint of `aws_region`. Can be set with the `GENESYSCLOUD_TOKEN_URL` environment variable.",
+							},
+							"token_refresh_interval": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      300,
+								Description:  "Number of seconds after which a token that has no known expiry is fetched again.",
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+				"rate_limit_requests_per_minute": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_RATE_LIMIT_REQUESTS_PER_MINUTE", 0),
+					Description:  "Max number of API requests per minute shared by all clients in the token pool. `0` only applies the back-off requested by the API through 429 responses and rate limit headers. Can be set with the `GENESYSCLOUD_RATE_LIMIT_REQUESTS_PER_MINUTE` environment variable.",
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"rate_limit_burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("GENESYSCLOUD_RATE_LIMIT_BURST", 10),
+					Description:  "Max number of API requests that can be sent at once before `rate_limit_requests_per_minute` applies. Can be set with the `GENESYSCLOUD_RATE_LIMIT_BURST` environment variable.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"consistency_checker_ignore_attributes": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Attributes that the consistency checker does not compare after creating or updating a resource, in the format `<resource type>.<attribute path>`, e.g. `genesyscloud_user.addresses.*.phone_numbers`. Nested attributes are separated by `.` and `*` matches any list index or set element.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringMatch(consistencyIgnoredAttributeRegex, "must be in the format <resource type>.<attribute path>"),
+					},
+				},
 				"proxy": {
 					Type:     schema.TypeSet,
 					Optional: true,
@@ -161,7 +247,7 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 			},
 			ResourcesMap:         copiedResources,
 			DataSourcesMap:       copiedDataSources,
-			ConfigureContextFunc: configure(version),
+			ConfigureContextFunc: configure(version, basePathFunc),
 		}
 	}
 }
@@ -169,34 +255,70 @@ func New(version string, providerResources map[string]*schema.Resource, provider
 type ProviderMeta struct {
 	Version      string
 	ClientConfig *platformclientv2.Configuration
+	ClientPool   *SDKClientPool
 	Domain       string
+
+	// Attribute paths not compared by the consistency checker, by resource type
+	ConsistencyIgnoredAttributes map[string][]string
+}
+
+var consistencyIgnoredAttributeRegex = regexp.MustCompile(`^[a-z0-9_]+\.[A-Za-z0-9_*]+(\.[A-Za-z0-9_*]+)*$`)
+
+// getConsistencyIgnoredAttributes indexes the consistency_checker_ignore_attributes entries by resource type
+func getConsistencyIgnoredAttributes(data *schema.ResourceData) map[string][]string {
+	ignoredAttributes := make(map[string][]string)
+	for _, entry := range data.Get("consistency_checker_ignore_attributes").([]interface{}) {
+		resourceType, path, _ := strings.Cut(entry.(string), ".")
+		ignoredAttributes[resourceType] = append(ignoredAttributes[resourceType], path)
+	}
+	return ignoredAttributes
 }
 
-func configure(version string) schema.ConfigureContextFunc {
+// The SDK default configuration shares the credentials of the first configured provider.
+// It is used by tests and anything else that doesn't go through a provider's client pool.
+var defaultConfigOnce sync.Once
+
+func configure(version string, basePathFunc BasePathFunc) schema.ConfigureContextFunc {
 	return func(context context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var (
+			clientPool *SDKClientPool
+			err        diag.Diagnostics
+		)
+
 		// Initialize a single client if we have an access token
 		accessToken := data.Get("access_token").(string)
 		if accessToken != "" {
-			Once.Do(func() {
-				sdkConfig := platformclientv2.GetDefaultConfiguration()
-				_ = InitClientConfig(data, version, sdkConfig)
-
-				SdkClientPool = &SDKClientPool{
-					Pool: make(chan *platformclientv2.Configuration, 1),
-				}
-				SdkClientPool.Pool <- sdkConfig
-			})
+			sdkConfig := platformclientv2.NewConfiguration()
+			if err := initClientConfig(data, version, sdkConfig, basePathFunc); err != nil {
+				return nil, err
+			}
+			clientPool = newSingleClientPool(sdkConfig, newRateLimiterFromConfig(data))
 		} else {
-			// Initialize the SDK Client pool
-			err := InitSDKClientPool(data.Get("token_pool_size").(int), version, data)
+			// Initialize the SDK Client pool for this provider instance
+			clientPool, err = NewSDKClientPool(data.Get("token_pool_size").(int), version, data, basePathFunc)
 			if err != nil {
 				return nil, err
 			}
 		}
+
+		// Each provider instance uses a client config of its own pool so aliased providers don't share credentials
+		clientConfig := clientPool.clientConfig
+
+		// Providers of a test harness only serve a single test
+		if basePathFunc == nil {
+			defaultConfigOnce.Do(func() {
+				log.Print("Initializing default SDK client.")
+				shareClientConfig(platformclientv2.GetDefaultConfiguration(), clientConfig)
+			})
+		}
+
 		return &ProviderMeta{
 			Version:      version,
-			ClientConfig: platformclientv2.GetDefaultConfiguration(),
+			ClientConfig: clientConfig,
+			ClientPool:   clientPool,
 			Domain:       getRegionDomain(data.Get("aws_region").(string)),
+
+			ConsistencyIgnoredAttributes: getConsistencyIgnoredAttributes(data),
 		}, nil
 	}
 }
@@ -241,10 +363,17 @@ func GetRegionBasePath(region string) string {
 }
 
 func InitClientConfig(data *schema.ResourceData, version string, config *platformclientv2.Configuration) diag.Diagnostics {
+	return initClientConfig(data, version, config, nil)
+}
+
+func initClientConfig(data *schema.ResourceData, version string, config *platformclientv2.Configuration, basePathFunc BasePathFunc) diag.Diagnostics {
 	accessToken := data.Get("access_token").(string)
 	oauthclientID := data.Get("oauthclient_id").(string)
 	oauthclientSecret := data.Get("oauthclient_secret").(string)
 	basePath := GetRegionBasePath(data.Get("aws_region").(string))
+	if basePathFunc != nil {
+		basePath = basePathFunc(basePath)
+	}
 	config.BasePath = basePath
 
 	diagErr := setUpSDKLogging(data, config)
@@ -270,9 +399,19 @@ func InitClientConfig(data *schema.ResourceData, version string, config *platfor
 		},
 	}
 
+	source, refreshInterval, diagErr := getTokenSource(data)
+	if diagErr != nil {
+		return diagErr
+	}
+
 	if accessToken != "" {
 		log.Print("Setting access token set on configuration instance.")
 		config.AccessToken = accessToken
+	} else if source != nil {
+		log.Printf("Authorizing configuration instance with %s.", source.description())
+		if diagErr := authorizeWithTokenSource(config, source, refreshInterval); diagErr != nil {
+			return diagErr
+		}
 	} else {
 		config.AutomaticTokenRefresh = true // Enable automatic token refreshing
 
@@ -293,6 +432,21 @@ func InitClientConfig(data *schema.ResourceData, version string, config *platfor
 	return nil
 }
 
+// shareClientConfig points config at the same org as an already authorized client config, without requesting another token
+func shareClientConfig(config *platformclientv2.Configuration, authorizedConfig *platformclientv2.Configuration) {
+	config.BasePath = authorizedConfig.BasePath
+	config.AccessToken = authorizedConfig.AccessToken
+	config.AccessTokenExpiresIn = authorizedConfig.AccessTokenExpiresIn
+	config.ClientID = authorizedConfig.ClientID
+	config.ClientSecret = authorizedConfig.ClientSecret
+	for name, value := range authorizedConfig.DefaultHeader {
+		config.AddDefaultHeader(name, value)
+	}
+	config.RetryConfiguration = authorizedConfig.RetryConfiguration
+	config.LoggingConfiguration = authorizedConfig.LoggingConfiguration
+	config.ProxyConfiguration = authorizedConfig.ProxyConfiguration
+}
+
 func withRetries(ctx context.Context, timeout time.Duration, method func() *retry.RetryError) diag.Diagnostics {
 	err := diag.FromErr(retry.RetryContext(ctx, timeout, method))
 	if err != nil && strings.Contains(fmt.Sprintf("%v", err), "timeout while waiting for state to become") {
@@ -20,6 +20,17 @@ func GetProviderFactories(providerResources map[string]*schema.Resource, provide
 	}
 }
 
+// GetProviderFactoriesWithBasePathFunc returns factories of providers that send their requests to the base path
+// returned by basePathFunc instead of the region's API, e.g. the server of a test's HTTP cassette.
+func GetProviderFactoriesWithBasePathFunc(providerResources map[string]*schema.Resource, providerDataSources map[string]*schema.Resource, basePathFunc BasePathFunc) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"genesyscloud": func() (*schema.Provider, error) {
+			provider := newProvider("0.1.0", providerResources, providerDataSources, basePathFunc)()
+			return provider, nil
+		},
+	}
+}
+
 // Verify default division is home division
 func TestDefaultHomeDivision(resource string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
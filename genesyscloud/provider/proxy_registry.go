@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+// clientPoolIds maps every client config handed out by an SDKClientPool to the id of that pool
+var clientPoolIds sync.Map
+
+func registerClientConfig(clientConfig *platformclientv2.Configuration, poolId string) {
+	clientPoolIds.Store(clientConfig, poolId)
+}
+
+// ClientPoolKey returns the key identifying the provider instance a client config belongs to.
+// Configs that were not created by a pool (e.g. in tests) are keyed on their own address.
+func ClientPoolKey(clientConfig *platformclientv2.Configuration) string {
+	if poolId, ok := clientPoolIds.Load(clientConfig); ok {
+		return poolId.(string)
+	}
+	return fmt.Sprintf("%p", clientConfig)
+}
+
+// ProxyRegistry holds one proxy per configured provider instance. Resource packages use it instead
+// of a package level singleton so that aliased providers pointing at different orgs each get a proxy
+// bound to their own credentials, while pooled clients of the same provider still share a proxy (and its cache).
+type ProxyRegistry[T any] struct {
+	proxies sync.Map
+}
+
+// NewProxyRegistry creates an empty ProxyRegistry
+func NewProxyRegistry[T any]() *ProxyRegistry[T] {
+	return &ProxyRegistry[T]{}
+}
+
+// Get returns the proxy for the provider instance owning clientConfig, creating it with newProxy on first use
+func (r *ProxyRegistry[T]) Get(clientConfig *platformclientv2.Configuration, newProxy func(*platformclientv2.Configuration) *T) *T {
+	key := ClientPoolKey(clientConfig)
+	if proxy, ok := r.proxies.Load(key); ok {
+		return proxy.(*T)
+	}
+	proxy, _ := r.proxies.LoadOrStore(key, newProxy(clientConfig))
+	return proxy.(*T)
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+type testProxy struct {
+	clientConfig *platformclientv2.Configuration
+}
+
+func newTestProxy(clientConfig *platformclientv2.Configuration) *testProxy {
+	return &testProxy{clientConfig: clientConfig}
+}
+
+func TestUnitProxyRegistryPerProviderInstance(t *testing.T) {
+	registry := NewProxyRegistry[testProxy]()
+
+	prodPool := newSingleClientPool(platformclientv2.NewConfiguration(), newRateLimiter(0, 1))
+	drPool := newSingleClientPool(platformclientv2.NewConfiguration(), newRateLimiter(0, 1))
+
+	prodConfigA := platformclientv2.NewConfiguration()
+	prodConfigB := platformclientv2.NewConfiguration()
+	drConfig := platformclientv2.NewConfiguration()
+	registerClientConfig(prodConfigA, prodPool.id)
+	registerClientConfig(prodConfigB, prodPool.id)
+	registerClientConfig(drConfig, drPool.id)
+
+	prodProxy := registry.Get(prodConfigA, newTestProxy)
+	if registry.Get(prodConfigB, newTestProxy) != prodProxy {
+		t.Errorf("expected clients of the same provider instance to share a proxy")
+	}
+
+	drProxy := registry.Get(drConfig, newTestProxy)
+	if drProxy == prodProxy {
+		t.Errorf("expected clients of different provider instances to get different proxies")
+	}
+	if drProxy.clientConfig != drConfig {
+		t.Errorf("expected proxy to be bound to the client config of its own provider instance")
+	}
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+const (
+	// Headers returned by Genesys Cloud describing the rate limit of the token used for a request
+	rateLimitCountHeader   = "inin-ratelimit-count"
+	rateLimitAllowedHeader = "inin-ratelimit-allowed"
+	rateLimitResetHeader   = "inin-ratelimit-reset"
+
+	// Slow down once a token has used this fraction of its allowed requests
+	rateLimitHighWatermark = 0.9
+	// The rate is never reduced below this fraction of the configured rate
+	rateLimitMinFactor = 0.1
+	// Waits shorter than this are not logged
+	rateLimitLogThreshold = time.Second
+)
+
+/*
+rateLimiter is a token bucket shared by every client config of an SDKClientPool. Each request waits for a token
+before it is sent, and each response is inspected for 429s and the rate limit headers. When the API pushes back
+all clients of the pool are paused together and the refill rate is reduced, after which it recovers gradually
+with every successful response.
+
+A requestsPerMinute of zero disables the steady rate limit, in which case the limiter only applies the pauses
+requested by the API.
+*/
+type rateLimiter struct {
+	mu sync.Mutex
+
+	configuredRate float64 // tokens per second
+	rate           float64 // current tokens per second, reduced while the API is pushing back
+	burst          float64
+	tokens         float64
+	lastRefill     time.Time
+	pausedUntil    time.Time
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func newRateLimiter(requestsPerMinute int, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	rate := float64(requestsPerMinute) / 60
+	return &rateLimiter{
+		configuredRate: rate,
+		rate:           rate,
+		burst:          float64(burst),
+		tokens:         float64(burst),
+		lastRefill:     time.Now(),
+		now:            time.Now,
+		sleep:          time.Sleep,
+	}
+}
+
+// newRateLimiterFromConfig creates the rate limiter described by the provider's rate limit settings
+func newRateLimiterFromConfig(data *schema.ResourceData) *rateLimiter {
+	requestsPerMinute, _ := data.Get("rate_limit_requests_per_minute").(int)
+	burst, _ := data.Get("rate_limit_burst").(int)
+	return newRateLimiter(requestsPerMinute, burst)
+}
+
+// attach hooks the limiter into the retry configuration of config so that every request, including retries,
+// goes through the limiter. Existing hooks are still called.
+func (l *rateLimiter) attach(config *platformclientv2.Configuration) {
+	if config.RetryConfiguration == nil {
+		config.RetryConfiguration = &platformclientv2.RetryConfiguration{}
+	}
+	requestLogHook := config.RetryConfiguration.RequestLogHook
+	responseLogHook := config.RetryConfiguration.ResponseLogHook
+
+	config.RetryConfiguration.RequestLogHook = func(request *http.Request, count int) {
+		if waited := l.wait(); waited >= rateLimitLogThreshold && request != nil {
+			log.Printf("Rate limiter delayed %s %s by %v", request.Method, request.URL, waited.Round(time.Millisecond))
+		}
+		if requestLogHook != nil {
+			requestLogHook(request, count)
+		}
+	}
+	config.RetryConfiguration.ResponseLogHook = func(response *http.Response) {
+		l.observe(response)
+		if responseLogHook != nil {
+			responseLogHook(response)
+		}
+	}
+}
+
+// wait blocks until the limiter allows another request and returns how long it waited
+func (l *rateLimiter) wait() time.Duration {
+	var waited time.Duration
+	for {
+		delay := l.reserve()
+		if delay <= 0 {
+			return waited
+		}
+		l.sleep(delay)
+		waited += delay
+	}
+}
+
+// reserve takes a token if one is available, otherwise it returns how long to wait before trying again
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.lastRefill).Seconds()*l.rate)
+	l.lastRefill = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// observe adjusts the limiter to a response from the API
+func (l *rateLimiter) observe(response *http.Response) {
+	if response == nil {
+		return
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		retryAfter := parseSeconds(response.Header.Get("Retry-After"))
+		if retryAfter <= 0 {
+			retryAfter = parseSeconds(response.Header.Get(rateLimitResetHeader))
+		}
+		if retryAfter <= 0 {
+			retryAfter = time.Second
+		}
+		log.Printf("Throttling all pooled clients for %v after %s %s returned %s", retryAfter, requestMethod(response), requestUrl(response), response.Status)
+		l.backOff(retryAfter)
+		return
+	}
+
+	count, countErr := strconv.Atoi(response.Header.Get(rateLimitCountHeader))
+	allowed, allowedErr := strconv.Atoi(response.Header.Get(rateLimitAllowedHeader))
+	if countErr == nil && allowedErr == nil && allowed > 0 && float64(count) >= float64(allowed)*rateLimitHighWatermark {
+		reset := parseSeconds(response.Header.Get(rateLimitResetHeader))
+		log.Printf("Throttling all pooled clients for %v, %d of %d allowed requests used", reset, count, allowed)
+		l.backOff(reset)
+		return
+	}
+
+	if response.StatusCode < http.StatusMultipleChoices {
+		l.recover()
+	}
+}
+
+// backOff pauses every client for the given duration and halves the refill rate
+func (l *rateLimiter) backOff(pause time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := l.now().Add(pause); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+	if l.configuredRate > 0 {
+		l.rate = math.Max(l.rate/2, l.configuredRate*rateLimitMinFactor)
+		l.tokens = 0
+		log.Printf("Reduced client side rate limit to %.0f requests per minute", l.rate*60)
+	}
+}
+
+// recover increases a reduced refill rate back towards the configured rate
+func (l *rateLimiter) recover() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate < l.configuredRate {
+		l.rate = math.Min(l.configuredRate, l.rate+l.configuredRate*rateLimitMinFactor/10)
+	}
+}
+
+func parseSeconds(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func requestMethod(response *http.Response) string {
+	if response.Request == nil {
+		return ""
+	}
+	return response.Request.Method
+}
+
+func requestUrl(response *http.Response) string {
+	if response.Request == nil || response.Request.URL == nil {
+		return ""
+	}
+	return response.Request.URL.String()
+}
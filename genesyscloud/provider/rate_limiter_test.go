@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// newTestRateLimiter returns a limiter running on a fake clock that is advanced by its sleeps
+func newTestRateLimiter(requestsPerMinute int, burst int) (*rateLimiter, *time.Time) {
+	clock := time.Unix(0, 0)
+	l := newRateLimiter(requestsPerMinute, burst)
+	l.lastRefill = clock
+	l.now = func() time.Time { return clock }
+	l.sleep = func(d time.Duration) { clock = clock.Add(d) }
+	return l, &clock
+}
+
+func TestUnitRateLimiterBurstAndRate(t *testing.T) {
+	l, clock := newTestRateLimiter(60, 2)
+	start := *clock
+
+	for i := 0; i < 2; i++ {
+		if waited := l.wait(); waited != 0 {
+			t.Fatalf("expected burst request %d not to wait, waited %v", i, waited)
+		}
+	}
+	l.wait()
+	if elapsed := clock.Sub(start); elapsed != time.Second {
+		t.Errorf("expected request after burst to wait 1s at 60 requests per minute, waited %v", elapsed)
+	}
+}
+
+func TestUnitRateLimiterRetryAfterPausesAllClients(t *testing.T) {
+	l, clock := newTestRateLimiter(0, 1)
+	start := *clock
+
+	l.observe(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Status:     "429 Too Many Requests",
+		Header:     http.Header{"Retry-After": []string{"5"}},
+	})
+	l.wait()
+	if elapsed := clock.Sub(start); elapsed != 5*time.Second {
+		t.Errorf("expected wait of 5s after Retry-After, waited %v", elapsed)
+	}
+}
+
+func TestUnitRateLimiterAdaptsToRateLimitHeaders(t *testing.T) {
+	l, _ := newTestRateLimiter(600, 10)
+
+	l.observe(&http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			http.CanonicalHeaderKey(rateLimitCountHeader):   []string{"290"},
+			http.CanonicalHeaderKey(rateLimitAllowedHeader): []string{"300"},
+			http.CanonicalHeaderKey(rateLimitResetHeader):   []string{"2"},
+		},
+	})
+	if l.rate != l.configuredRate/2 {
+		t.Fatalf("expected rate to be halved near the rate limit, got %v requests per second", l.rate)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.observe(&http.Response{StatusCode: http.StatusOK, Header: http.Header{}})
+	}
+	if l.rate != l.configuredRate {
+		t.Errorf("expected rate to recover to %v requests per second, got %v", l.configuredRate, l.rate)
+	}
+}
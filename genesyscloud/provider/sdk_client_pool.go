@@ -6,6 +6,7 @@ import (
 	"sync"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
@@ -15,36 +16,45 @@ import (
 // acquired at the beginning of any resource operation and released on completion.
 // This has the benefit of ensuring we don't issue too many concurrent requests and also
 // increases throughput as each token will have its own rate limit.
+// Every configured provider instance owns its own pool, so aliased providers pointing at
+// different orgs never share credentials. All clients of a pool share a single rate limiter.
 type SDKClientPool struct {
-	Pool chan *platformclientv2.Configuration
-}
-
-var SdkClientPool *SDKClientPool
-var SdkClientPoolErr diag.Diagnostics
-var Once sync.Once
-
-// InitSDKClientPool creates a new Pool of Clients with the given provider config
-// This must be called during provider initialization before the Pool is used
-func InitSDKClientPool(max int, version string, providerConfig *schema.ResourceData) diag.Diagnostics {
-	Once.Do(func() {
-		log.Print("Initializing default SDK client.")
-		// Initialize the default config for tests and anything else that doesn't use the Pool
-		err := InitClientConfig(providerConfig, version, platformclientv2.GetDefaultConfiguration())
-		if err != nil {
-			SdkClientPoolErr = err
-			return
-		}
+	Pool        chan *platformclientv2.Configuration
+	id          string
+	rateLimiter *rateLimiter
+	// clientConfig is the first client of the pool, used by the provider outside of pooled resource operations
+	clientConfig *platformclientv2.Configuration
+}
 
-		log.Printf("Initializing %d SDK clients in the Pool.", max)
-		SdkClientPool = &SDKClientPool{
-			Pool: make(chan *platformclientv2.Configuration, max),
-		}
-		SdkClientPoolErr = SdkClientPool.preFill(providerConfig, version)
-	})
-	return SdkClientPoolErr
+// NewSDKClientPool creates a new Pool of Clients with the given provider config
+func NewSDKClientPool(max int, version string, providerConfig *schema.ResourceData, basePathFunc BasePathFunc) (*SDKClientPool, diag.Diagnostics) {
+	log.Printf("Initializing %d SDK clients in the Pool.", max)
+	pool := &SDKClientPool{
+		Pool:        make(chan *platformclientv2.Configuration, max),
+		id:          uuid.NewString(),
+		rateLimiter: newRateLimiterFromConfig(providerConfig),
+	}
+	if err := pool.preFill(providerConfig, version, basePathFunc); err != nil {
+		return nil, err
+	}
+	return pool, nil
 }
 
-func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version string) diag.Diagnostics {
+// newSingleClientPool wraps an already initialized client config in a Pool of size one.
+// This is used when the provider is configured with a static access token.
+func newSingleClientPool(sdkConfig *platformclientv2.Configuration, rateLimiter *rateLimiter) *SDKClientPool {
+	pool := &SDKClientPool{
+		Pool:         make(chan *platformclientv2.Configuration, 1),
+		id:           uuid.NewString(),
+		rateLimiter:  rateLimiter,
+		clientConfig: sdkConfig,
+	}
+	pool.register(sdkConfig)
+	pool.Pool <- sdkConfig
+	return pool
+}
+
+func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version string, basePathFunc BasePathFunc) diag.Diagnostics {
 	errorChan := make(chan diag.Diagnostics)
 	wgDone := make(chan bool)
 	var wg sync.WaitGroup
@@ -53,10 +63,13 @@ func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version str
 	defer cancel()
 	for i := 0; i < cap(p.Pool); i++ {
 		sdkConfig := platformclientv2.NewConfiguration()
+		if i == 0 {
+			p.clientConfig = sdkConfig
+		}
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			err := InitClientConfig(providerConfig, version, sdkConfig)
+			err := initClientConfig(providerConfig, version, sdkConfig, basePathFunc)
 			if err != nil {
 				select {
 				case <-ctx.Done():
@@ -65,6 +78,7 @@ func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version str
 				cancel()
 				return
 			}
+			p.register(sdkConfig)
 		}()
 		p.Pool <- sdkConfig
 	}
@@ -82,6 +96,13 @@ func (p *SDKClientPool) preFill(providerConfig *schema.ResourceData, version str
 	}
 }
 
+// register marks an initialized client config as belonging to this pool and routes its requests through the
+// pool's rate limiter
+func (p *SDKClientPool) register(c *platformclientv2.Configuration) {
+	registerClientConfig(c, p.id)
+	p.rateLimiter.attach(c)
+}
+
 func (p *SDKClientPool) acquire() *platformclientv2.Configuration {
 	return <-p.Pool
 }
@@ -94,6 +115,20 @@ func (p *SDKClientPool) release(c *platformclientv2.Configuration) {
 	}
 }
 
+type sdkClientPoolContextKey struct{}
+
+// WithSDKClientPool returns a copy of ctx carrying the given pool. Exporter getAll* functions
+// only receive a context, so this is how they find the pool of the provider instance running the export.
+func WithSDKClientPool(ctx context.Context, pool *SDKClientPool) context.Context {
+	return context.WithValue(ctx, sdkClientPoolContextKey{}, pool)
+}
+
+// SDKClientPoolFromContext returns the pool previously attached to ctx with WithSDKClientPool
+func SDKClientPoolFromContext(ctx context.Context) (*SDKClientPool, bool) {
+	pool, ok := ctx.Value(sdkClientPoolContextKey{}).(*SDKClientPool)
+	return pool, ok && pool != nil
+}
+
 type resContextFunc func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
 type GetAllConfigFunc func(context.Context, *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics)
 type GetCustomConfigFunc func(context.Context, *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics)
@@ -118,8 +153,9 @@ func DeleteWithPooledClient(method resContextFunc) schema.DeleteContextFunc {
 // and automatically return it to the Pool on completion
 func runWithPooledClient(method resContextFunc) resContextFunc {
 	return func(ctx context.Context, r *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+		providerMeta := meta.(*ProviderMeta)
+		clientConfig := providerMeta.ClientPool.acquire()
+		defer providerMeta.ClientPool.release(clientConfig)
 
 		// Check if the request has been cancelled
 		select {
@@ -129,7 +165,7 @@ func runWithPooledClient(method resContextFunc) resContextFunc {
 		}
 
 		// Copy to a new providerMeta object and set the sdk config
-		newMeta := *meta.(*ProviderMeta)
+		newMeta := *providerMeta
 		newMeta.ClientConfig = clientConfig
 		return method(ctx, r, &newMeta)
 	}
@@ -138,8 +174,12 @@ func runWithPooledClient(method resContextFunc) resContextFunc {
 // Inject a pooled SDK client connection into an exporter's getAll* method
 func GetAllWithPooledClient(method GetAllConfigFunc) resourceExporter.GetAllResourcesFunc {
 	return func(ctx context.Context) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+		pool, ok := SDKClientPoolFromContext(ctx)
+		if !ok {
+			return nil, diag.Errorf("no SDK client pool found in context")
+		}
+		clientConfig := pool.acquire()
+		defer pool.release(clientConfig)
 
 		// Check if the request has been cancelled
 		select {
@@ -154,8 +194,12 @@ func GetAllWithPooledClient(method GetAllConfigFunc) resourceExporter.GetAllReso
 
 func GetAllWithPooledClientCustom(method GetCustomConfigFunc) resourceExporter.GetAllCustomResourcesFunc {
 	return func(ctx context.Context) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
-		clientConfig := SdkClientPool.acquire()
-		defer SdkClientPool.release(clientConfig)
+		pool, ok := SDKClientPoolFromContext(ctx)
+		if !ok {
+			return nil, nil, diag.Errorf("no SDK client pool found in context")
+		}
+		clientConfig := pool.acquire()
+		defer pool.release(clientConfig)
 
 		// Check if the request has been cancelled
 		select {
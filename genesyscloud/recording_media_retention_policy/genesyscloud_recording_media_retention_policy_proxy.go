@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -34,6 +35,9 @@ Each proxy implementation:
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *policyProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[policyProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllPoliciesFunc func(ctx context.Context, p *policyProxy) (*[]platformclientv2.Policy, *platformclientv2.APIResponse, error)
 type createPolicyFunc func(ctx context.Context, p *policyProxy, policyCreate *platformclientv2.Policycreate) (*platformclientv2.Policy, *platformclientv2.APIResponse, error)
@@ -81,13 +85,13 @@ func newPolicyProxy(clientConfig *platformclientv2.Configuration) *policyProxy {
 	}
 }
 
-// getPolicyProxy acts as a singleton to for the internalProxy.  It also ensures
+// getPolicyProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getPolicyProxy(clientConfig *platformclientv2.Configuration) *policyProxy {
-	if internalProxy == nil {
-		internalProxy = newPolicyProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newPolicyProxy)
 }
 
 // getAllPolicies retrieves all Genesys Cloud Recording Media Retention Policies
@@ -0,0 +1,129 @@
+package resource_cache
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
+	featureToggles "terraform-provider-genesyscloud/genesyscloud/util/feature_toggles"
+)
+
+/*
+Batch reads serve the reads of a resource type during plan and apply from its cache, which is filled by listing all the
+objects of the type on the first read instead of getting each object with its own API call. They are enabled with the
+ENABLE_BATCH_READS environment variable. Resources using batch reads must remove the objects they create, update or
+delete from the cache with DeleteCacheItem, so that the next read of these objects goes to the API.
+*/
+
+type batchReadState struct {
+	name     string
+	prefetch sync.Once
+	mutex    sync.Mutex
+	hits     int
+	misses   int
+
+	// Objects changed since the cache was filled, they are always read with the API
+	invalidated map[string]bool
+}
+
+var (
+	batchReadStates      = make(map[interface{}]*batchReadState)
+	batchReadStatesMutex sync.Mutex
+)
+
+// IsBatchReadActive reports whether reads are served from the caches filled by listing all the objects of a type.
+// The exporter manages its caches itself.
+func IsBatchReadActive() bool {
+	return featureToggles.BatchReadsToggleExists() && !tfexporter_state.IsExporterActive()
+}
+
+func getBatchReadState[T any](cache CacheInterface[T]) *batchReadState {
+	batchReadStatesMutex.Lock()
+	defer batchReadStatesMutex.Unlock()
+	state, ok := batchReadStates[cache]
+	if !ok {
+		state = &batchReadState{name: fmt.Sprintf("%T", *new(T)), invalidated: make(map[string]bool)}
+		batchReadStates[cache] = state
+	}
+	return state
+}
+
+// GetBatchReadCacheItem returns an object from the cache when batch reads are active. The first call for a cache fills
+// it with the objects returned by getAll, keyed by getKey. It returns nil when the object must be read with the API,
+// e.g. because it was created or updated after the objects were listed.
+func GetBatchReadCacheItem[T any](cache CacheInterface[T], key string, getAll func() (*[]T, error), getKey func(T) string) *T {
+	if !IsBatchReadActive() {
+		return nil
+	}
+
+	state := getBatchReadState(cache)
+	state.prefetch.Do(func() {
+		items, err := getAll()
+		if err != nil {
+			log.Printf("Failed to list the %s objects for batch reads, they will be read one at a time: %v", state.name, err)
+			return
+		}
+		if items == nil {
+			return
+		}
+		state.mutex.Lock()
+		defer state.mutex.Unlock()
+		for _, item := range *items {
+			// Objects changed while they were listed may be out of date
+			if itemKey := getKey(item); !state.invalidated[itemKey] {
+				cache.Set(itemKey, item)
+			}
+		}
+		log.Printf("Listed %d %s objects for batch reads", len(*items), state.name)
+	})
+
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	if !state.invalidated[key] {
+		if value, ok := cache.Get(key); ok {
+			state.hits++
+			return &value
+		}
+	}
+	state.misses++
+	log.Printf("Batch read cache miss for %s %s, will do API call to fetch (%s)", state.name, key, state.hitRate())
+	return nil
+}
+
+// invalidateBatchReadItem makes the next reads of an object go to the API
+func invalidateBatchReadItem[T any](cache CacheInterface[T], key string) {
+	state := getBatchReadState(cache)
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	state.invalidated[key] = true
+	cache.Delete(key)
+}
+
+func (s *batchReadState) hitRate() string {
+	total := s.hits + s.misses
+	if total == 0 {
+		return "no reads"
+	}
+	return fmt.Sprintf("%d hits, %d misses, %.0f%% hit rate", s.hits, s.misses, float64(s.hits)*100/float64(total))
+}
+
+// LogBatchReadStats logs the hit rate of the batch reads of each type. It is called when the provider shuts down.
+func LogBatchReadStats() {
+	batchReadStatesMutex.Lock()
+	defer batchReadStatesMutex.Unlock()
+
+	var stats []string
+	for _, state := range batchReadStates {
+		state.mutex.Lock()
+		stats = append(stats, fmt.Sprintf("%s: %s", state.name, state.hitRate()))
+		state.mutex.Unlock()
+	}
+	if len(stats) == 0 {
+		return
+	}
+	sort.Strings(stats)
+	for _, stat := range stats {
+		log.Printf("Batch reads of %s", stat)
+	}
+}
@@ -0,0 +1,58 @@
+package resource_cache
+
+import (
+	"errors"
+	featureToggles "terraform-provider-genesyscloud/genesyscloud/util/feature_toggles"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBatchReadCacheItem(t *testing.T) {
+	cache := NewResourceCache[testCachedObject]()
+	listCalls := 0
+	getAll := func() (*[]testCachedObject, error) {
+		listCalls++
+		return &[]testCachedObject{{Id: "1", Name: "first"}, {Id: "2", Name: "second"}}, nil
+	}
+	getKey := func(object testCachedObject) string {
+		return object.Id
+	}
+
+	// Batch reads are opt-in
+	assert.Nil(t, GetBatchReadCacheItem(cache, "1", getAll, getKey))
+	assert.Equal(t, 0, listCalls)
+
+	t.Setenv(featureToggles.BatchReadsToggleName(), "true")
+	assert.Equal(t, &testCachedObject{Id: "1", Name: "first"}, GetBatchReadCacheItem(cache, "1", getAll, getKey))
+	assert.Equal(t, &testCachedObject{Id: "2", Name: "second"}, GetBatchReadCacheItem(cache, "2", getAll, getKey))
+	assert.Nil(t, GetBatchReadCacheItem(cache, "3", getAll, getKey))
+	assert.Equal(t, 1, listCalls)
+
+	// Changed objects are read with the API from then on
+	DeleteCacheItem(cache, "2")
+	assert.Nil(t, GetBatchReadCacheItem(cache, "2", getAll, getKey))
+	cache.Set("2", testCachedObject{Id: "2", Name: "stale"})
+	assert.Nil(t, GetBatchReadCacheItem(cache, "2", getAll, getKey))
+
+	state := getBatchReadState(cache)
+	assert.Equal(t, "2 hits, 3 misses, 40% hit rate", state.hitRate())
+}
+
+func TestUnitBatchReadCacheItemListError(t *testing.T) {
+	t.Setenv(featureToggles.BatchReadsToggleName(), "true")
+	cache := NewResourceCache[testCachedObject]()
+	listCalls := 0
+	getAll := func() (*[]testCachedObject, error) {
+		listCalls++
+		return nil, errors.New("list failed")
+	}
+	getKey := func(object testCachedObject) string {
+		return object.Id
+	}
+
+	// The objects are not listed again after a failure
+	assert.Nil(t, GetBatchReadCacheItem(cache, "1", getAll, getKey))
+	assert.Nil(t, GetBatchReadCacheItem(cache, "1", getAll, getKey))
+	assert.Equal(t, 1, listCalls)
+}
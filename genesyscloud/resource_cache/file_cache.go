@@ -0,0 +1,183 @@
+package resource_cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// fileCache stores its items as JSON in a file, so that later runs of the provider reuse them until they are older
+// than the TTL. Items are written to the file when the cache is marked complete and when the caches are flushed.
+type fileCache[T any] struct {
+	lock        sync.Mutex
+	path        string
+	ttl         time.Duration
+	items       map[string]fileCacheItem[T]
+	completedAt *time.Time
+	dirty       bool
+}
+
+type fileCacheItem[T any] struct {
+	Value    T         `json:"value"`
+	StoredAt time.Time `json:"storedAt"`
+}
+
+// fileCacheContents is the format of the cache file
+type fileCacheContents[T any] struct {
+	// When all the objects of the cache type were last stored
+	CompletedAt *time.Time                  `json:"completedAt,omitempty"`
+	Items       map[string]fileCacheItem[T] `json:"items"`
+}
+
+// newFileCache creates a cache backed by the file at path, loading the items of the file that have not expired
+func newFileCache[T any](path string, ttl time.Duration) *fileCache[T] {
+	c := &fileCache[T]{
+		path:  path,
+		ttl:   ttl,
+		items: make(map[string]fileCacheItem[T]),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("Failed to read cache file %s, starting with an empty cache: %v", path, err)
+		}
+		return c
+	}
+	var contents fileCacheContents[T]
+	if err := json.Unmarshal(data, &contents); err != nil {
+		log.Printf("Failed to parse cache file %s, starting with an empty cache: %v", path, err)
+		return c
+	}
+	for key, item := range contents.Items {
+		if !c.isExpired(item.StoredAt) {
+			c.items[key] = item
+		}
+	}
+	if contents.CompletedAt != nil && !c.isExpired(*contents.CompletedAt) {
+		c.completedAt = contents.CompletedAt
+	}
+	log.Printf("Loaded %d items from cache file %s", len(c.items), path)
+	return c
+}
+
+func (c *fileCache[T]) isExpired(storedAt time.Time) bool {
+	return time.Since(storedAt) > c.ttl
+}
+
+// Set stores a value in the cache
+func (c *fileCache[T]) Set(key string, value T) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.items[key] = fileCacheItem[T]{Value: value, StoredAt: time.Now()}
+	c.dirty = true
+}
+
+// Delete removes a value from the cache. The cache no longer holds all the objects of its type.
+func (c *fileCache[T]) Delete(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.items, key)
+	c.completedAt = nil
+	c.dirty = true
+}
+
+// Get retrieves a value from the cache if it has not expired
+func (c *fileCache[T]) Get(key string) (T, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	item, ok := c.items[key]
+	if !ok || c.isExpired(item.StoredAt) {
+		var zero T
+		return zero, false
+	}
+	return item.Value, true
+}
+
+// GetAll retrieves all the values from the cache that have not expired
+func (c *fileCache[T]) GetAll() []T {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var values []T
+	for _, item := range c.items {
+		if !c.isExpired(item.StoredAt) {
+			values = append(values, item.Value)
+		}
+	}
+	return values
+}
+
+// GetSize retrieves the number of values in the cache that have not expired
+func (c *fileCache[T]) GetSize() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	size := 0
+	for _, item := range c.items {
+		if !c.isExpired(item.StoredAt) {
+			size++
+		}
+	}
+	return size
+}
+
+// SetComplete records that the cache holds all the objects of its type and writes it to its file
+func (c *fileCache[T]) SetComplete() {
+	c.lock.Lock()
+	now := time.Now()
+	c.completedAt = &now
+	c.dirty = true
+	c.lock.Unlock()
+
+	if err := c.Flush(); err != nil {
+		log.Print(err)
+	}
+}
+
+// IsComplete reports whether the cache holds all the objects of its type and they have not expired
+func (c *fileCache[T]) IsComplete() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.completedAt != nil && !c.isExpired(*c.completedAt)
+}
+
+// Clear removes all the values from the cache
+func (c *fileCache[T]) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.items = make(map[string]fileCacheItem[T])
+	c.completedAt = nil
+	c.dirty = true
+}
+
+// Flush writes the cache to its file if it changed since it was last written
+func (c *fileCache[T]) Flush() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.dirty {
+		return nil
+	}
+
+	data, err := json.Marshal(fileCacheContents[T]{CompletedAt: c.completedAt, Items: c.items})
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache file %s: %v", c.path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create cache directory %s: %v", filepath.Dir(c.path), err)
+	}
+	// Write to a temporary file first so that an interrupted write does not leave a truncated cache file
+	tmpPath := c.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write cache file %s: %v", c.path, err)
+	}
+	if err := os.Rename(tmpPath, c.path); err != nil {
+		return fmt.Errorf("failed to write cache file %s: %v", c.path, err)
+	}
+	c.dirty = false
+	return nil
+}
@@ -0,0 +1,67 @@
+package resource_cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCachedObject struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestUnitFileCacheReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "objects.json")
+
+	cache := newFileCache[testCachedObject](path, time.Hour)
+	cache.Set("1", testCachedObject{Id: "1", Name: "first"})
+	cache.Set("2", testCachedObject{Id: "2", Name: "second"})
+	assert.False(t, cache.IsComplete())
+	cache.SetComplete()
+
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	reloaded := newFileCache[testCachedObject](path, time.Hour)
+	assert.True(t, reloaded.IsComplete())
+	assert.Equal(t, 2, reloaded.GetSize())
+	value, ok := reloaded.Get("2")
+	assert.True(t, ok)
+	assert.Equal(t, testCachedObject{Id: "2", Name: "second"}, value)
+
+	// Deleting an object means the cache no longer holds all of them
+	reloaded.Delete("1")
+	assert.False(t, reloaded.IsComplete())
+	assert.Nil(t, reloaded.Flush())
+	assert.Equal(t, 1, newFileCache[testCachedObject](path, time.Hour).GetSize())
+}
+
+func TestUnitFileCacheExpiry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "objects.json")
+	old := time.Now().Add(-2 * time.Hour)
+	recent := time.Now().Add(-10 * time.Minute)
+	data, _ := json.Marshal(fileCacheContents[testCachedObject]{
+		CompletedAt: &old,
+		Items: map[string]fileCacheItem[testCachedObject]{
+			"old":    {Value: testCachedObject{Id: "old"}, StoredAt: old},
+			"recent": {Value: testCachedObject{Id: "recent"}, StoredAt: recent},
+		},
+	})
+	assert.Nil(t, os.WriteFile(path, data, 0600))
+
+	cache := newFileCache[testCachedObject](path, time.Hour)
+	assert.False(t, cache.IsComplete())
+	assert.Equal(t, []testCachedObject{{Id: "recent"}}, cache.GetAll())
+	_, ok := cache.Get("old")
+	assert.False(t, ok)
+
+	// A corrupted file starts an empty cache
+	assert.Nil(t, os.WriteFile(path, []byte("{"), 0600))
+	assert.Equal(t, 0, newFileCache[testCachedObject](path, time.Hour).GetSize())
+}
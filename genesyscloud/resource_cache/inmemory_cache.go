@@ -3,8 +3,9 @@ package resource_cache
 import "sync"
 
 type inMemoryCache[T any] struct {
-	lock sync.Mutex
-	data map[string]T
+	lock     sync.Mutex
+	data     map[string]T
+	complete bool
 }
 
 // Set stores a value in the in-memory cache
@@ -18,6 +19,7 @@ func (c *inMemoryCache[T]) Delete(key string) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	delete(c.data, key)
+	c.complete = false
 }
 
 // Get retrieves a value from the in-memory cache
@@ -48,3 +50,25 @@ func (c *inMemoryCache[T]) GetSize() int {
 
 	return len(c.data)
 }
+
+// SetComplete records that the in-memory cache holds all the objects of its type
+func (c *inMemoryCache[T]) SetComplete() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.complete = true
+}
+
+// IsComplete reports whether the in-memory cache holds all the objects of its type
+func (c *inMemoryCache[T]) IsComplete() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.complete
+}
+
+// Clear removes all the values from the in-memory cache
+func (c *inMemoryCache[T]) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.data = make(map[string]T)
+	c.complete = false
+}
@@ -1,8 +1,18 @@
 package resource_cache
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
 
 type CacheInterface[T any] interface {
@@ -11,8 +21,28 @@ type CacheInterface[T any] interface {
 	GetAll() []T
 	GetSize() int
 	Delete(key string)
+
+	// SetComplete records that the cache holds all the objects of its type, e.g. after they were all listed
+	SetComplete()
+	IsComplete() bool
+	Clear()
+}
+
+// persistentCacheSettings is where the caches of a provider instance store their values
+type persistentCacheSettings struct {
+	dir string
+	ttl time.Duration
 }
 
+var (
+	// Settings by client pool key, so that providers reading from different orgs never share cached objects
+	persistentCacheConfigs = make(map[string]persistentCacheSettings)
+	persistentCaches       = make(map[string]interface{})
+	persistentCacheMutex   sync.Mutex
+)
+
+var unsafeCacheNameChars = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
 // NewResourceCache is a factory method to return the cache implementation. We have made this a cache so we can plugin in
 func NewResourceCache[T any]() CacheInterface[T] {
 	return &inMemoryCache[T]{ //This will show as a missing type in goland, but it compiles.  I think golang is have a problem resolving this
@@ -20,6 +50,84 @@ func NewResourceCache[T any]() CacheInterface[T] {
 	}
 }
 
+// NewClientResourceCache returns a cache for the objects read with clientConfig, named after the type of its values.
+// It stores them in files if a persistent cache is configured for the provider instance of clientConfig.
+func NewClientResourceCache[T any](clientConfig *platformclientv2.Configuration) CacheInterface[T] {
+	return NewNamedClientResourceCache[T](clientConfig, fmt.Sprintf("%T", *new(T)))
+}
+
+// NewNamedClientResourceCache returns a cache for the objects read with clientConfig with a name that is unique among
+// the caches of the provider. The name is only needed when several caches hold values of the same type.
+func NewNamedClientResourceCache[T any](clientConfig *platformclientv2.Configuration, name string) CacheInterface[T] {
+	persistentCacheMutex.Lock()
+	defer persistentCacheMutex.Unlock()
+
+	settings, ok := persistentCacheConfigs[provider.ClientPoolKey(clientConfig)]
+	if !ok {
+		return NewResourceCache[T]()
+	}
+
+	// Caches with the same name share the same file, so they must share the same items too
+	path := filepath.Join(settings.dir, unsafeCacheNameChars.ReplaceAllString(name, "_")+".json")
+	if cache, ok := persistentCaches[path].(*fileCache[T]); ok {
+		return cache
+	}
+	cache := newFileCache[T](path, settings.ttl)
+	persistentCaches[path] = cache
+	return cache
+}
+
+// ConfigurePersistentCache makes the caches created afterwards for the provider instance of clientConfig store their
+// values in files under dir, where later runs of the provider reuse them until they are older than ttl. dir must be
+// specific to the org of clientConfig. An empty dir keeps the values of new caches in memory.
+func ConfigurePersistentCache(clientConfig *platformclientv2.Configuration, dir string, ttl time.Duration) {
+	persistentCacheMutex.Lock()
+	defer persistentCacheMutex.Unlock()
+	key := provider.ClientPoolKey(clientConfig)
+	if dir == "" {
+		delete(persistentCacheConfigs, key)
+		return
+	}
+	persistentCacheConfigs[key] = persistentCacheSettings{dir: dir, ttl: ttl}
+}
+
+// FlushPersistentCaches writes the values of the persistent caches to their files
+func FlushPersistentCaches() error {
+	persistentCacheMutex.Lock()
+	defer persistentCacheMutex.Unlock()
+
+	var errs []error
+	for _, cache := range persistentCaches {
+		if err := cache.(interface{ Flush() error }).Flush(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ClearPersistentCache deletes the cache files under dir, so that every object is read again from the API
+func ClearPersistentCache(dir string) error {
+	persistentCacheMutex.Lock()
+	defer persistentCacheMutex.Unlock()
+
+	// Caches that are already in use are emptied as well
+	for path, cache := range persistentCaches {
+		if filepath.Dir(path) == filepath.Clean(dir) {
+			cache.(interface{ Clear() }).Clear()
+		}
+	}
+	cacheFiles, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, cacheFile := range cacheFiles {
+		if err := os.Remove(cacheFile); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to delete cache file %s: %v", cacheFile, err)
+		}
+	}
+	return nil
+}
+
 func SetCache[T any](cache CacheInterface[T], key string, value T) {
 	if tfexporter_state.IsExporterActive() {
 		cache.Set(key, value)
@@ -29,6 +137,8 @@ func SetCache[T any](cache CacheInterface[T], key string, value T) {
 func DeleteCacheItem[T any](cache CacheInterface[T], key string) {
 	if tfexporter_state.IsExporterActive() {
 		cache.Delete(key)
+	} else if IsBatchReadActive() {
+		invalidateBatchReadItem(cache, key)
 	}
 }
 
@@ -61,3 +171,28 @@ func GetCacheSize[T any](cache CacheInterface[T]) int {
 
 	return 0
 }
+
+// SetCacheComplete records that the cache holds all the objects of its type after they were listed with the API
+func SetCacheComplete[T any](cache CacheInterface[T]) {
+	if tfexporter_state.IsExporterActive() {
+		cache.SetComplete()
+	}
+}
+
+// GetCompleteCache returns all the values of the cache if it holds all the objects of its type, so that they do not
+// need to be listed with the API again
+func GetCompleteCache[T any](cache CacheInterface[T]) *[]T {
+	if tfexporter_state.IsExporterActive() && cache.IsComplete() {
+		items := cache.GetAll()
+		log.Printf("Cache holds all %d objects, skipping API calls to list them", len(items))
+		return &items
+	}
+	return nil
+}
+
+// ClearCache removes all the values from the cache, e.g. when they no longer match the objects listed with the API
+func ClearCache[T any](cache CacheInterface[T]) {
+	if tfexporter_state.IsExporterActive() {
+		cache.Clear()
+	}
+}
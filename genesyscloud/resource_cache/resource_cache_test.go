@@ -1,8 +1,14 @@
 package resource_cache
 
 import (
+	"path/filepath"
+	"sort"
 	"terraform-provider-genesyscloud/genesyscloud/tfexporter_state"
 	"testing"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestUnitWithoutExporterState(t *testing.T) {
@@ -41,3 +47,51 @@ func TestUnitSetCacheAndGetCache(t *testing.T) {
 		t.Errorf("Expected key 'nonexistent' to not exist in the cache")
 	}
 }
+
+func TestUnitPersistentResourceCache(t *testing.T) {
+	tfexporter_state.ActivateExporterState()
+	dir := t.TempDir()
+	clientConfig := &platformclientv2.Configuration{}
+	ConfigurePersistentCache(clientConfig, dir, time.Hour)
+	defer ConfigurePersistentCache(clientConfig, "", 0)
+
+	cache := NewClientResourceCache[testCachedObject](clientConfig)
+	assert.Same(t, cache, NewClientResourceCache[testCachedObject](clientConfig))
+	assert.NotSame(t, cache, NewNamedClientResourceCache[testCachedObject](clientConfig, "other objects"))
+
+	assert.Nil(t, GetCompleteCache(cache))
+	SetCache(cache, "1", testCachedObject{Id: "1"})
+	SetCacheComplete(cache)
+	assert.Equal(t, &[]testCachedObject{{Id: "1"}}, GetCompleteCache(cache))
+
+	SetCache(NewNamedClientResourceCache[testCachedObject](clientConfig, "other objects"), "2", testCachedObject{Id: "2"})
+	assert.Nil(t, FlushPersistentCaches())
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	sort.Strings(files)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "other_objects.json"),
+		filepath.Join(dir, "resource_cache.testCachedObject.json"),
+	}, files)
+
+	// The caches of a provider reading from another org are kept apart
+	otherClientConfig := &platformclientv2.Configuration{}
+	_, inMemory := NewClientResourceCache[testCachedObject](otherClientConfig).(*inMemoryCache[testCachedObject])
+	assert.True(t, inMemory)
+	otherDir := t.TempDir()
+	ConfigurePersistentCache(otherClientConfig, otherDir, time.Hour)
+	defer ConfigurePersistentCache(otherClientConfig, "", 0)
+	otherCache := NewClientResourceCache[testCachedObject](otherClientConfig)
+	assert.NotSame(t, cache, otherCache)
+	assert.Nil(t, GetCompleteCache(otherCache))
+
+	assert.Nil(t, ClearPersistentCache(dir))
+	assert.Nil(t, GetCompleteCache(cache))
+	assert.Equal(t, 0, cache.GetSize())
+	files, _ = filepath.Glob(filepath.Join(dir, "*.json"))
+	assert.Empty(t, files)
+
+	// Caches created without a directory keep their values in memory
+	ConfigurePersistentCache(clientConfig, "", 0)
+	_, inMemory = NewClientResourceCache[testCachedObject](clientConfig).(*inMemoryCache[testCachedObject])
+	assert.True(t, inMemory)
+}
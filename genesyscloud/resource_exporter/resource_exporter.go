@@ -24,6 +24,11 @@ type ResourceMeta struct {
 
 	// Prefix to add to the ID when reading state
 	IdPrefix string
+
+	// Version or modification date of the object as returned when listing it. Incremental exports reuse the
+	// previously exported state of objects whose version hasn't changed, so only set this when every change to
+	// the exported attributes also changes the version.
+	Version string
 }
 
 // ResourceIDMetaMap is a map of IDs to ResourceMeta
@@ -49,6 +54,9 @@ type ResourceInfo struct {
 	Name    string
 	Type    string
 	CtyType cty.Type
+
+	// ID to import the resource with: the ID returned by GetResourcesFunc prefixed with the IdPrefix of its ResourceMeta
+	ImportId string
 }
 
 // RefAttrCustomResolver allows the definition of a custom resolver for an exporter.
@@ -125,6 +133,9 @@ type ResourceExporter struct {
 
 	// Map of resource id->names. This is set after a call to loadSanitizedResourceMap
 	SanitizedResourceMap ResourceIDMetaMap
+	// Set of every resource id returned by GetResourcesFunc, including the ones removed by FilterResource.
+	// This is set after a call to loadSanitizedResourceMap
+	ListedResourceIds map[string]bool
 	// List of attributes to exclude from config. This is set by the export configuration.
 	ExcludedAttributes []string
 
@@ -143,8 +154,17 @@ type ResourceExporter struct {
 
 	//This a placeholder filter out specific resources from a filter.
 	FilterResource func(ResourceIDMetaMap, string, []string) ResourceIDMetaMap
+
+	// Sanitizer of the resource names, set by the export configuration. NewSanitizerProvider is used if it is not set.
+	NameSanitizer Sanitizer
+
 	// Attributes that are mentioned with custom exports like e164 numbers,rrule  should be ensured to export in the correct format (remove hyphens, whitespace, etc.)
 	CustomValidateExports map[string][]string
+
+	// Resource types that manage the same objects in a different form, e.g. one resource per row of a datatable.
+	// A resource with ReplacesResourceTypes is only exported when it is explicitly included, and the resource
+	// types it replaces are then left out of the export so that the same objects are not managed twice.
+	ReplacesResourceTypes []string
 	mutex                 sync.RWMutex
 }
 
@@ -154,6 +174,11 @@ func (r *ResourceExporter) LoadSanitizedResourceMap(ctx context.Context, name st
 		return err
 	}
 
+	listedIds := make(map[string]bool, len(result))
+	for id := range result {
+		listedIds[id] = true
+	}
+
 	if r.FilterResource != nil {
 		result = r.FilterResource(result, name, filter)
 	}
@@ -161,10 +186,14 @@ func (r *ResourceExporter) LoadSanitizedResourceMap(ctx context.Context, name st
 	// Lock the Resource Map as it is accessed by goroutines
 	r.mutex.Lock()
 	r.SanitizedResourceMap = result
+	r.ListedResourceIds = listedIds
 	r.mutex.Unlock()
 
-	sanitizer := NewSanitizerProvider()
-	sanitizer.S.Sanitize(r.SanitizedResourceMap)
+	sanitizer := r.NameSanitizer
+	if sanitizer == nil {
+		sanitizer = NewSanitizerProvider().S
+	}
+	sanitizer.Sanitize(r.SanitizedResourceMap)
 
 	return nil
 }
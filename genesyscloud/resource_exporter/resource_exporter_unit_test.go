@@ -290,3 +290,62 @@ func TestUnitSanitizeResourceNameOptimized(t *testing.T) {
 		}
 	}
 }
+
+// Tests that the id_suffix strategy names resources the same way whatever the other resources are
+func TestUnitSanitizeResourceNamesIdSuffix(t *testing.T) {
+	sanitizer, err := NewSanitizerProviderByStrategy(SanitizerStrategyIdSuffix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metaMap := make(ResourceIDMetaMap)
+	metaMap["1"] = &ResourceMeta{Name: "Sales Queue"}
+	sanitizer.S.Sanitize(metaMap)
+	firstName := metaMap["1"].Name
+	if !regexp.MustCompile("^Sales_Queue_[0-9a-f]{8}$").MatchString(firstName) {
+		t.Errorf("Sales Queue did not sanitize correctly!\nActual Output: %v", firstName)
+	}
+
+	metaMap = make(ResourceIDMetaMap)
+	metaMap["1"] = &ResourceMeta{Name: "Sales Queue"}
+	metaMap["2"] = &ResourceMeta{Name: "Sales Queue"}
+	sanitizer.S.Sanitize(metaMap)
+	if metaMap["1"].Name != firstName {
+		t.Errorf("Name of resource 1 changed when resource 2 was added!\nExpected Output: %v\nActual Output: %v", firstName, metaMap["1"].Name)
+	}
+	if metaMap["2"].Name == firstName {
+		t.Errorf("Resources 1 and 2 have the same name %v", firstName)
+	}
+
+	if _, err := NewSanitizerProviderByStrategy("unknown"); err == nil {
+		t.Error("Expected an error for an unknown strategy")
+	}
+}
+
+// Tests that pinned names are kept and never given to other resources
+func TestUnitSanitizeResourceNamesPinned(t *testing.T) {
+	metaMap := make(ResourceIDMetaMap)
+	metaMap["1"] = &ResourceMeta{Name: "Sales Queue"}
+	metaMap["2"] = &ResourceMeta{Name: "Sales Queue"}
+	metaMap["3"] = &ResourceMeta{Name: "Support"}
+	metaMap["4"] = &ResourceMeta{Name: "Billing"}
+
+	sanitizer := NewPinnedSanitizer(&sanitizerOptimized{}, map[string]string{
+		"2": "Sales_Queue",
+		// Pinned resource that is not part of this export
+		"5": "Billing",
+	})
+	sanitizer.Sanitize(metaMap)
+
+	expected := map[string]string{
+		"1": AppendIdSuffix("Sales_Queue", "1"),
+		"2": "Sales_Queue",
+		"3": "Support",
+		"4": AppendIdSuffix("Billing", "4"),
+	}
+	for id, name := range expected {
+		if metaMap[id].Name != name {
+			t.Errorf("Resource %s did not sanitize correctly!\nExpected Output: %v\nActual Output: %v", id, name, metaMap[id].Name)
+		}
+	}
+}
@@ -1,12 +1,22 @@
 package resource_exporter
 
 import (
+	"fmt"
 	"hash/fnv"
 	"log"
 	"os"
+	"sort"
 	"strconv"
 )
 
+// Naming strategies that can be selected in the export config
+const (
+	SanitizerStrategyOptimized      = "optimized"
+	SanitizerStrategyLegacy         = "legacy"
+	SanitizerStrategyIdSuffix       = "id_suffix"
+	SanitizerStrategyDivisionPrefix = "division_prefix"
+)
+
 type SanitizerProvider struct {
 	S Sanitizer
 }
@@ -20,6 +30,21 @@ type Sanitizer interface {
 type sanitizerOriginal struct{}
 type sanitizerOptimized struct{}
 
+// sanitizerIdSuffix appends a hash of the ID to every name, so names don't change when other resources are added
+type sanitizerIdSuffix struct{}
+
+// sanitizerDivisionPrefix leaves names that collide as they are. The exporter prefixes them with the name of their
+// division once their state is read, and resolves the remaining collisions with ResolveResourceNameCollisions.
+type sanitizerDivisionPrefix struct{}
+
+// sanitizerPinned keeps the names pinned by a previous export and names the other resources with its base Sanitizer
+type sanitizerPinned struct {
+	base Sanitizer
+
+	// Map of resource ID to pinned name
+	pinned map[string]string
+}
+
 // NewSanitizierProvider returns a Sanitizer. Without a GENESYS_SANITIZER_LEGACY environment variable set it will always use the optimized Sanitizer
 func NewSanitizerProvider() *SanitizerProvider {
 	// Check if the environment variable is set
@@ -40,6 +65,29 @@ func NewSanitizerProvider() *SanitizerProvider {
 
 }
 
+// NewSanitizerProviderByStrategy returns the Sanitizer of a naming strategy. Without a strategy it falls back to NewSanitizerProvider
+func NewSanitizerProviderByStrategy(strategy string) (*SanitizerProvider, error) {
+	switch strategy {
+	case "":
+		return NewSanitizerProvider(), nil
+	case SanitizerStrategyOptimized:
+		return &SanitizerProvider{S: &sanitizerOptimized{}}, nil
+	case SanitizerStrategyLegacy:
+		return &SanitizerProvider{S: &sanitizerOriginal{}}, nil
+	case SanitizerStrategyIdSuffix:
+		return &SanitizerProvider{S: &sanitizerIdSuffix{}}, nil
+	case SanitizerStrategyDivisionPrefix:
+		return &SanitizerProvider{S: &sanitizerDivisionPrefix{}}, nil
+	}
+	return nil, fmt.Errorf("unknown resource naming strategy %s", strategy)
+}
+
+// NewPinnedSanitizer returns a Sanitizer that names the resources in pinned, a map of resource ID to name, with their
+// pinned name. The other resources are named by base, and get an ID suffix when their name is already taken.
+func NewPinnedSanitizer(base Sanitizer, pinned map[string]string) Sanitizer {
+	return &sanitizerPinned{base: base, pinned: pinned}
+}
+
 // Sanitize sanitizes all the resource names using the original algorithm
 func (so *sanitizerOriginal) Sanitize(idMetaMap ResourceIDMetaMap) {
 	for _, meta := range idMetaMap {
@@ -109,3 +157,81 @@ func (sod *sanitizerOptimized) SanitizeResourceName(inputName string) string {
 
 	return name
 }
+
+// Sanitize sanitizes all resource names and appends a hash of their ID
+func (sis *sanitizerIdSuffix) Sanitize(idMetaMap ResourceIDMetaMap) {
+	for id, meta := range idMetaMap {
+		meta.Name = AppendIdSuffix(sis.SanitizeResourceName(meta.Name), id)
+	}
+}
+
+// SanitizeResourceName sanitizes a single resource name
+func (sis *sanitizerIdSuffix) SanitizeResourceName(inputName string) string {
+	return (&sanitizerOptimized{}).SanitizeResourceName(inputName)
+}
+
+// Sanitize sanitizes all resource names without resolving collisions
+func (sdp *sanitizerDivisionPrefix) Sanitize(idMetaMap ResourceIDMetaMap) {
+	for _, meta := range idMetaMap {
+		meta.Name = sdp.SanitizeResourceName(meta.Name)
+	}
+}
+
+// SanitizeResourceName sanitizes a single resource name
+func (sdp *sanitizerDivisionPrefix) SanitizeResourceName(inputName string) string {
+	return (&sanitizerOptimized{}).SanitizeResourceName(inputName)
+}
+
+// Sanitize names the pinned resources with their pinned name and the other ones with the base Sanitizer
+func (sp *sanitizerPinned) Sanitize(idMetaMap ResourceIDMetaMap) {
+	unpinned := make(ResourceIDMetaMap)
+	for id, meta := range idMetaMap {
+		if name, ok := sp.pinned[id]; ok {
+			meta.Name = name
+		} else {
+			unpinned[id] = meta
+		}
+	}
+
+	// The names of pinned resources that are not part of this export are not reused either
+	reserved := make(map[string]bool)
+	for _, name := range sp.pinned {
+		reserved[name] = true
+	}
+	sp.base.Sanitize(unpinned)
+	ResolveResourceNameCollisions(unpinned, reserved)
+}
+
+// SanitizeResourceName sanitizes a single resource name
+func (sp *sanitizerPinned) SanitizeResourceName(inputName string) string {
+	return sp.base.SanitizeResourceName(inputName)
+}
+
+// ResolveResourceNameCollisions appends an ID suffix to the names that are reserved or used by another resource of
+// idMetaMap. Resources are visited in the order of their IDs, so the first one keeps its name.
+func ResolveResourceNameCollisions(idMetaMap ResourceIDMetaMap, reserved map[string]bool) {
+	ids := make([]string, 0, len(idMetaMap))
+	for id := range idMetaMap {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	used := make(map[string]bool)
+	for name := range reserved {
+		used[name] = true
+	}
+	for _, id := range ids {
+		meta := idMetaMap[id]
+		if used[meta.Name] {
+			meta.Name = AppendIdSuffix(meta.Name, id)
+		}
+		used[meta.Name] = true
+	}
+}
+
+// AppendIdSuffix appends a hash of a resource ID to its name. The hash is the same in every export of the resource.
+func AppendIdSuffix(name string, id string) string {
+	algorithm := fnv.New32()
+	algorithm.Write([]byte(id))
+	return fmt.Sprintf("%s_%08x", name, algorithm.Sum32())
+}
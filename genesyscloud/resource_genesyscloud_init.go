@@ -1,6 +1,7 @@
 package genesyscloud
 
 import (
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 )
 
@@ -9,6 +10,7 @@ func SetRegistrar(l registrar.Registrar) {
 	registerDataSources(l)
 	registerResources(l)
 	registerExporters(l)
+	registerConsistencyRules()
 }
 
 func registerDataSources(l registrar.Registrar) {
@@ -99,3 +101,8 @@ func registerExporters(l registrar.Registrar) {
 	l.RegisterExporter("genesyscloud_knowledge_v1_category", KnowledgeCategoryExporterV1())
 
 }
+
+func registerConsistencyRules() {
+	// The API stores the email of a user in lower case
+	consistency_checker.RegisterAttributeRules("genesyscloud_user", consistency_checker.CaseInsensitive("email"))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
 	"time"
@@ -20,24 +21,62 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
 
-func getAllRoutingSkills(_ context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
-	resources := make(resourceExporter.ResourceIDMetaMap)
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(clientConfig)
+// routingSkillCaches hold the skills listed for batch reads, one cache per provider instance
+var routingSkillCaches = provider.NewProxyRegistry[rc.CacheInterface[platformclientv2.Routingskill]]()
 
+func getRoutingSkillCache(clientConfig *platformclientv2.Configuration) rc.CacheInterface[platformclientv2.Routingskill] {
+	return *routingSkillCaches.Get(clientConfig, func(*platformclientv2.Configuration) *rc.CacheInterface[platformclientv2.Routingskill] {
+		cache := rc.NewResourceCache[platformclientv2.Routingskill]()
+		return &cache
+	})
+}
+
+// listRoutingSkills returns every skill of the org, including the deleted ones
+func listRoutingSkills(routingAPI *platformclientv2.RoutingApi) (*[]platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
+	var allSkills []platformclientv2.Routingskill
 	for pageNum := 1; ; pageNum++ {
 		const pageSize = 100
 		skills, resp, getErr := routingAPI.GetRoutingSkills(pageSize, pageNum, "", nil)
 		if getErr != nil {
-			return nil, util.BuildAPIDiagnosticError("genesyscloud_routing_skill", fmt.Sprintf("Failed to get skills error: %s", getErr), resp)
+			return nil, resp, getErr
 		}
 
 		if skills.Entities == nil || len(*skills.Entities) == 0 {
 			break
 		}
+		allSkills = append(allSkills, *skills.Entities...)
+	}
+	return &allSkills, nil, nil
+}
+
+// getRoutingSkill reads a skill, from the batch read cache when batch reads are active
+func getRoutingSkill(clientConfig *platformclientv2.Configuration, routingAPI *platformclientv2.RoutingApi, skillId string) (*platformclientv2.Routingskill, *platformclientv2.APIResponse, error) {
+	skill := rc.GetBatchReadCacheItem(getRoutingSkillCache(clientConfig), skillId, func() (*[]platformclientv2.Routingskill, error) {
+		skills, _, err := listRoutingSkills(routingAPI)
+		return skills, err
+	}, func(skill platformclientv2.Routingskill) string {
+		return *skill.Id
+	})
+	if skill != nil {
+		return skill, nil, nil
+	}
+	return routingAPI.GetRoutingSkill(skillId)
+}
+
+func getAllRoutingSkills(_ context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(clientConfig)
+
+	skills, resp, getErr := listRoutingSkills(routingAPI)
+	if getErr != nil {
+		return nil, util.BuildAPIDiagnosticError("genesyscloud_routing_skill", fmt.Sprintf("Failed to get skills error: %s", getErr), resp)
+	}
 
-		for _, skill := range *skills.Entities {
-			if skill.State != nil && *skill.State != "deleted" {
-				resources[*skill.Id] = &resourceExporter.ResourceMeta{Name: *skill.Name}
+	for _, skill := range *skills {
+		if skill.State != nil && *skill.State != "deleted" {
+			resources[*skill.Id] = &resourceExporter.ResourceMeta{Name: *skill.Name}
+			if skill.Version != nil {
+				resources[*skill.Id].Version = *skill.Version
 			}
 		}
 	}
@@ -89,6 +128,7 @@ func createRoutingSkill(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	d.SetId(*skill.Id)
+	rc.DeleteCacheItem(getRoutingSkillCache(sdkConfig), d.Id())
 
 	log.Printf("Created skill %s %s", name, *skill.Id)
 	return readRoutingSkill(ctx, d, meta)
@@ -101,7 +141,7 @@ func readRoutingSkill(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	log.Printf("Reading skill %s", d.Id())
 	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
-		skill, resp, getErr := routingAPI.GetRoutingSkill(d.Id())
+		skill, resp, getErr := getRoutingSkill(sdkConfig, routingAPI, d.Id())
 		if getErr != nil {
 			if util.IsStatus404(resp) {
 				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError("genesyscloud_routing_skill", fmt.Sprintf("Failed to read skill %s | error: %s", d.Id(), getErr), resp))
@@ -131,6 +171,7 @@ func deleteRoutingSkill(ctx context.Context, d *schema.ResourceData, meta interf
 	if err != nil {
 		return util.BuildAPIDiagnosticError("genesyscloud_routing_skill", fmt.Sprintf("Failed to delete skill %s error: %s", name, err), resp)
 	}
+	rc.DeleteCacheItem(getRoutingSkillCache(sdkConfig), d.Id())
 
 	return util.WithRetries(ctx, 30*time.Second, func() *retry.RetryError {
 		routingSkill, resp, err := routingAPI.GetRoutingSkill(d.Id())
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
 	"time"
@@ -20,23 +21,61 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
 
-func getAllRoutingWrapupCodes(_ context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
-	resources := make(resourceExporter.ResourceIDMetaMap)
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(clientConfig)
+// routingWrapupCodeCaches hold the wrapup codes listed for batch reads, one cache per provider instance
+var routingWrapupCodeCaches = provider.NewProxyRegistry[rc.CacheInterface[platformclientv2.Wrapupcode]]()
 
+func getRoutingWrapupCodeCache(clientConfig *platformclientv2.Configuration) rc.CacheInterface[platformclientv2.Wrapupcode] {
+	return *routingWrapupCodeCaches.Get(clientConfig, func(*platformclientv2.Configuration) *rc.CacheInterface[platformclientv2.Wrapupcode] {
+		cache := rc.NewResourceCache[platformclientv2.Wrapupcode]()
+		return &cache
+	})
+}
+
+// listRoutingWrapupCodes returns every wrapup code of the org
+func listRoutingWrapupCodes(routingAPI *platformclientv2.RoutingApi) (*[]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error) {
+	var allWrapupcodes []platformclientv2.Wrapupcode
 	for pageNum := 1; ; pageNum++ {
 		const pageSize = 100
 		wrapupcodes, resp, getErr := routingAPI.GetRoutingWrapupcodes(pageSize, pageNum, "", "", "", []string{}, []string{})
 		if getErr != nil {
-			return nil, util.BuildAPIDiagnosticError("genesyscloud_routing_wrapupcode", fmt.Sprintf("Failed to get wrapupcodes error: %s", getErr), resp)
+			return nil, resp, getErr
 		}
 
 		if wrapupcodes.Entities == nil || len(*wrapupcodes.Entities) == 0 {
 			break
 		}
+		allWrapupcodes = append(allWrapupcodes, *wrapupcodes.Entities...)
+	}
+	return &allWrapupcodes, nil, nil
+}
+
+// getRoutingWrapupCode reads a wrapup code, from the batch read cache when batch reads are active
+func getRoutingWrapupCode(clientConfig *platformclientv2.Configuration, routingAPI *platformclientv2.RoutingApi, codeId string) (*platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error) {
+	wrapupcode := rc.GetBatchReadCacheItem(getRoutingWrapupCodeCache(clientConfig), codeId, func() (*[]platformclientv2.Wrapupcode, error) {
+		wrapupcodes, _, err := listRoutingWrapupCodes(routingAPI)
+		return wrapupcodes, err
+	}, func(wrapupcode platformclientv2.Wrapupcode) string {
+		return *wrapupcode.Id
+	})
+	if wrapupcode != nil {
+		return wrapupcode, nil, nil
+	}
+	return routingAPI.GetRoutingWrapupcode(codeId)
+}
+
+func getAllRoutingWrapupCodes(_ context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(clientConfig)
+
+	wrapupcodes, resp, getErr := listRoutingWrapupCodes(routingAPI)
+	if getErr != nil {
+		return nil, util.BuildAPIDiagnosticError("genesyscloud_routing_wrapupcode", fmt.Sprintf("Failed to get wrapupcodes error: %s", getErr), resp)
+	}
 
-		for _, wrapupcode := range *wrapupcodes.Entities {
-			resources[*wrapupcode.Id] = &resourceExporter.ResourceMeta{Name: *wrapupcode.Name}
+	for _, wrapupcode := range *wrapupcodes {
+		resources[*wrapupcode.Id] = &resourceExporter.ResourceMeta{Name: *wrapupcode.Name}
+		if wrapupcode.DateModified != nil {
+			resources[*wrapupcode.Id].Version = wrapupcode.DateModified.String()
 		}
 	}
 
@@ -87,6 +126,7 @@ func createRoutingWrapupCode(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	d.SetId(*wrapupcode.Id)
+	rc.DeleteCacheItem(getRoutingWrapupCodeCache(sdkConfig), d.Id())
 	log.Printf("Created wrapupcode %s %s", name, *wrapupcode.Id)
 	return readRoutingWrapupCode(ctx, d, meta)
 }
@@ -98,7 +138,7 @@ func readRoutingWrapupCode(ctx context.Context, d *schema.ResourceData, meta int
 
 	log.Printf("Reading wrapupcode %s", d.Id())
 	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
-		wrapupcode, resp, getErr := routingAPI.GetRoutingWrapupcode(d.Id())
+		wrapupcode, resp, getErr := getRoutingWrapupCode(sdkConfig, routingAPI, d.Id())
 		if getErr != nil {
 			if util.IsStatus404(resp) {
 				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError("genesyscloud_routing_wrapupcode", fmt.Sprintf("Failed to read wrapupcode %s | error: %s", d.Id(), getErr), resp))
@@ -126,6 +166,7 @@ func updateRoutingWrapupCode(ctx context.Context, d *schema.ResourceData, meta i
 	if err != nil {
 		return util.BuildAPIDiagnosticError("genesyscloud_routing_wrapupcode", fmt.Sprintf("Failed to update wrapupcode %s error: %s", name, err), resp)
 	}
+	rc.DeleteCacheItem(getRoutingWrapupCodeCache(sdkConfig), d.Id())
 
 	log.Printf("Updated wrapupcode %s", name)
 
@@ -143,6 +184,7 @@ func deleteRoutingWrapupCode(ctx context.Context, d *schema.ResourceData, meta i
 	if err != nil {
 		return util.BuildAPIDiagnosticError("genesyscloud_routing_wrapupcode", fmt.Sprintf("Failed to delete wrapupcode %s error: %s", name, err), resp)
 	}
+	rc.DeleteCacheItem(getRoutingWrapupCodeCache(sdkConfig), d.Id())
 
 	return util.WithRetries(ctx, 30*time.Second, func() *retry.RetryError {
 		_, resp, err := routingAPI.GetRoutingWrapupcode(d.Id())
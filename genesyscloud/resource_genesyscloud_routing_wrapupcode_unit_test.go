@@ -0,0 +1,50 @@
+package genesyscloud
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util/fakeapi"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/** Unit Test **/
+func TestUnitResourceRoutingWrapupCodeLifecycle(t *testing.T) {
+	server := fakeapi.New(t)
+	ctx := context.Background()
+	gc := &provider.ProviderMeta{ClientConfig: server.ClientConfig()}
+	resourceSchema := ResourceRoutingWrapupCode().Schema
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"name": "Unit Test Wrapup Code"})
+	diag := createRoutingWrapupCode(ctx, d, gc)
+	assert.False(t, diag.HasError(), "%v", diag)
+	assert.NotEmpty(t, d.Id())
+
+	wrapupcodePath := "/api/v2/routing/wrapupcodes/" + d.Id()
+	stored, ok := server.Get("/api/v2/routing/wrapupcodes", d.Id())
+	assert.True(t, ok)
+	assert.Equal(t, "Unit Test Wrapup Code", stored["name"])
+
+	// Reads survive throttling
+	server.InjectFault(fakeapi.Fault{Method: http.MethodGet, PathPrefix: wrapupcodePath, StatusCode: http.StatusTooManyRequests, Times: 1})
+	d.Set("name", "Renamed Unit Test Wrapup Code")
+	diag = updateRoutingWrapupCode(ctx, d, gc)
+	assert.False(t, diag.HasError(), "%v", diag)
+	stored, _ = server.Get("/api/v2/routing/wrapupcodes", d.Id())
+	assert.Equal(t, "Renamed Unit Test Wrapup Code", stored["name"])
+
+	// Import only knows the id
+	imported := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	imported.SetId(d.Id())
+	diag = readRoutingWrapupCode(ctx, imported, gc)
+	assert.False(t, diag.HasError(), "%v", diag)
+	assert.Equal(t, "Renamed Unit Test Wrapup Code", imported.Get("name").(string))
+
+	diag = deleteRoutingWrapupCode(ctx, d, gc)
+	assert.False(t, diag.HasError(), "%v", diag)
+	_, ok = server.Get("/api/v2/routing/wrapupcodes", d.Id())
+	assert.False(t, ok)
+}
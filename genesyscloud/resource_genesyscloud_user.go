@@ -7,6 +7,7 @@ import (
 	"log"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	routingUtilization "terraform-provider-genesyscloud/genesyscloud/routing_utilization"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
@@ -128,46 +129,87 @@ var (
 	}
 )
 
-func GetAllUsers(ctx context.Context, sdkConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
-	resources := make(resourceExporter.ResourceIDMetaMap)
-	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+// userExpands are the expands needed to read every attribute of a user
+var userExpands = []string{
+	"skills",
+	"languages",
+	"locations",
+	"profileSkills",
+	"certifications",
+	"employerInfo",
+}
 
-	// Newly created resources often aren't returned unless there's a delay
-	time.Sleep(5 * time.Second)
+// userCaches hold the users listed for batch reads, one cache per provider instance
+var userCaches = provider.NewProxyRegistry[rc.CacheInterface[platformclientv2.User]]()
+
+func getUserCache(clientConfig *platformclientv2.Configuration) rc.CacheInterface[platformclientv2.User] {
+	return *userCaches.Get(clientConfig, func(*platformclientv2.Configuration) *rc.CacheInterface[platformclientv2.User] {
+		cache := rc.NewResourceCache[platformclientv2.User]()
+		return &cache
+	})
+}
 
-	// Inner function to get user based on status
-	getUsersByStatus := func(userStatus string) (*[]platformclientv2.User, error) {
-		users := []platformclientv2.User{}
-		const pageSize = 100
+// getUsersByStatus returns every user of the org in a state
+func getUsersByStatus(usersAPI *platformclientv2.UsersApi, userStatus string, expand []string) (*[]platformclientv2.User, error) {
+	users := []platformclientv2.User{}
+	const pageSize = 100
 
-		usersList, _, err := usersAPI.GetUsers(pageSize, 1, nil, nil, "", nil, "", userStatus)
+	usersList, _, err := usersAPI.GetUsers(pageSize, 1, nil, nil, "", expand, "", userStatus)
+	if err != nil {
+		return nil, err
+	}
+	users = append(users, *usersList.Entities...)
+
+	for pageNum := 2; pageNum <= *usersList.PageCount; pageNum++ {
+		usersList, _, err := usersAPI.GetUsers(pageSize, pageNum, nil, nil, "", expand, "", userStatus)
 		if err != nil {
 			return nil, err
 		}
+
 		users = append(users, *usersList.Entities...)
+	}
 
-		for pageNum := 2; pageNum <= *usersList.PageCount; pageNum++ {
-			usersList, _, err := usersAPI.GetUsers(pageSize, pageNum, nil, nil, "", nil, "", userStatus)
+	return &users, nil
+}
+
+// getUser reads a user with all of its expands, from the batch read cache when batch reads are active
+func getUser(clientConfig *platformclientv2.Configuration, usersAPI *platformclientv2.UsersApi, userId string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+	user := rc.GetBatchReadCacheItem(getUserCache(clientConfig), userId, func() (*[]platformclientv2.User, error) {
+		allUsers := []platformclientv2.User{}
+		for _, userStatus := range []string{"active", "inactive"} {
+			users, err := getUsersByStatus(usersAPI, userStatus, userExpands)
 			if err != nil {
 				return nil, err
 			}
-
-			users = append(users, *usersList.Entities...)
+			allUsers = append(allUsers, *users...)
 		}
-
-		return &users, nil
+		return &allUsers, nil
+	}, func(user platformclientv2.User) string {
+		return *user.Id
+	})
+	if user != nil {
+		return user, nil, nil
 	}
+	return usersAPI.GetUser(userId, userExpands, "", "")
+}
+
+func GetAllUsers(ctx context.Context, sdkConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	// Newly created resources often aren't returned unless there's a delay
+	time.Sleep(5 * time.Second)
 
 	// Get all "active" and "inactive" users
 	allUsers := []platformclientv2.User{}
 
-	activeUsers, err := getUsersByStatus("active")
+	activeUsers, err := getUsersByStatus(usersAPI, "active", nil)
 	if err != nil {
 		return nil, util.BuildDiagnosticError("genesyscloud_user", fmt.Sprintf("failed to get 'active' users"), err)
 	}
 	allUsers = append(allUsers, *activeUsers...)
 
-	inactiveUsers, err := getUsersByStatus("inactive")
+	inactiveUsers, err := getUsersByStatus(usersAPI, "inactive", nil)
 	if err != nil {
 		return nil, util.BuildDiagnosticError("genesyscloud_user", fmt.Sprintf("failed to get 'inactive' users"), err)
 	}
@@ -493,6 +535,7 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	}
 
 	d.SetId(*user.Id)
+	rc.DeleteCacheItem(getUserCache(sdkConfig), d.Id())
 
 	// Set attributes that can only be modified in a patch
 	if d.HasChanges(
@@ -547,15 +590,7 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 
 	log.Printf("Reading user %s", d.Id())
 	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
-		currentUser, resp, getErr := usersAPI.GetUser(d.Id(), []string{
-			// Expands
-			"skills",
-			"languages",
-			"locations",
-			"profileSkills",
-			"certifications",
-			"employerInfo",
-		}, "", "")
+		currentUser, resp, getErr := getUser(sdkConfig, usersAPI, d.Id())
 
 		if getErr != nil {
 			if util.IsStatus404(resp) {
@@ -629,6 +664,7 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	}
 
 	log.Printf("Updating user %s", email)
+	rc.DeleteCacheItem(getUserCache(sdkConfig), d.Id())
 
 	// If state changes, it is the only modifiable field, so it must be updated separately
 	if d.HasChange("state") {
@@ -701,6 +737,7 @@ func deleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 			return resp, util.BuildAPIDiagnosticError("genesyscloud_user", fmt.Sprintf("Failed to delete user %s error: %s", d.Id(), err), resp)
 		}
 		log.Printf("Deleted user %s", email)
+		rc.DeleteCacheItem(getUserCache(sdkConfig), d.Id())
 		return nil, nil
 	})
 	if err != nil {
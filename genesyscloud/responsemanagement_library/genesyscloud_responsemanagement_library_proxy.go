@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -16,6 +17,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *responsemanagementLibraryProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[responsemanagementLibraryProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createResponsemanagementLibraryFunc func(ctx context.Context, p *responsemanagementLibraryProxy, library *platformclientv2.Library) (*platformclientv2.Library, *platformclientv2.APIResponse, error)
 type getAllResponsemanagementLibraryFunc func(ctx context.Context, p *responsemanagementLibraryProxy, name string) (*[]platformclientv2.Library, *platformclientv2.APIResponse, error)
@@ -51,13 +55,13 @@ func newResponsemanagementLibraryProxy(clientConfig *platformclientv2.Configurat
 	}
 }
 
-// getResponsemanagementLibraryProxy acts as a singleton to for the internalProxy.  It also ensures
+// getResponsemanagementLibraryProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getResponsemanagementLibraryProxy(clientConfig *platformclientv2.Configuration) *responsemanagementLibraryProxy {
-	if internalProxy == nil {
-		internalProxy = newResponsemanagementLibraryProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newResponsemanagementLibraryProxy)
 }
 
 // createResponsemanagementLibrary creates a Genesys Cloud responsemanagement library
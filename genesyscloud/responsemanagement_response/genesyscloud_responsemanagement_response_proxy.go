@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 /*
@@ -16,6 +17,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *responsemanagementResponseProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[responsemanagementResponseProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createResponsemanagementResponseFunc func(ctx context.Context, p *responsemanagementResponseProxy, response *platformclientv2.Response) (responseManagementResponse *platformclientv2.Response, resp *platformclientv2.APIResponse, err error)
 type getAllResponsemanagementResponseFunc func(ctx context.Context, p *responsemanagementResponseProxy, libraryId string) (*[]platformclientv2.Response, *platformclientv2.APIResponse, error)
@@ -51,13 +55,13 @@ func newResponsemanagementResponseProxy(clientConfig *platformclientv2.Configura
 	}
 }
 
-// getResponsemanagementResponseProxy acts as a singleton to for the internalProxy.  It also ensures
+// getResponsemanagementResponseProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getResponsemanagementResponseProxy(clientConfig *platformclientv2.Configuration) *responsemanagementResponseProxy {
-	if internalProxy == nil {
-		internalProxy = newResponsemanagementResponseProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newResponsemanagementResponseProxy)
 }
 
 // createResponsemanagementResponse creates a Genesys Cloud responsemanagement response
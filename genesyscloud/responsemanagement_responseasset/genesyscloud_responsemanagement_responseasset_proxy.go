@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
@@ -18,6 +19,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *responsemanagementResponseassetProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[responsemanagementResponseassetProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllResponseAssetsFunc func(ctx context.Context, p *responsemanagementResponseassetProxy) (*[]platformclientv2.Responseasset, *platformclientv2.APIResponse, error)
 type createRespManagementRespAssetFunc func(ctx context.Context, p *responsemanagementResponseassetProxy, respAsset *platformclientv2.Createresponseassetrequest) (*platformclientv2.Createresponseassetresponse, *platformclientv2.APIResponse, error)
@@ -42,7 +46,7 @@ type responsemanagementResponseassetProxy struct {
 // newRespManagementRespAssetProxy initializes the responsemanagement responseasset proxy with all of the data needed to communicate with Genesys Cloud
 func newRespManagementRespAssetProxy(clientConfig *platformclientv2.Configuration) *responsemanagementResponseassetProxy {
 	api := platformclientv2.NewResponseManagementApiWithConfig(clientConfig)
-	assetCache := rc.NewResourceCache[platformclientv2.Responseasset]()
+	assetCache := rc.NewClientResourceCache[platformclientv2.Responseasset](clientConfig)
 	return &responsemanagementResponseassetProxy{
 		clientConfig:                         clientConfig,
 		responseManagementApi:                api,
@@ -56,13 +60,13 @@ func newRespManagementRespAssetProxy(clientConfig *platformclientv2.Configuratio
 	}
 }
 
-// getRespManagementRespAssetProxy acts as a singleton to for the internalProxy.  It also ensures
+// getRespManagementRespAssetProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getRespManagementRespAssetProxy(clientConfig *platformclientv2.Configuration) *responsemanagementResponseassetProxy {
-	if internalProxy == nil {
-		internalProxy = newRespManagementRespAssetProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newRespManagementRespAssetProxy)
 }
 
 func (p *responsemanagementResponseassetProxy) getAllResponseAssets(ctx context.Context) (*[]platformclientv2.Responseasset, *platformclientv2.APIResponse, error) {
@@ -93,6 +97,10 @@ func (p *responsemanagementResponseassetProxy) deleteRespManagementRespAsset(ctx
 }
 
 func getAllResponseAssetsFn(ctx context.Context, p *responsemanagementResponseassetProxy) (*[]platformclientv2.Responseasset, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.assetCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	var allResponseAssets []platformclientv2.Responseasset
 	var response *platformclientv2.APIResponse
 	pageSize := 100
@@ -130,6 +138,7 @@ func getAllResponseAssetsFn(ctx context.Context, p *responsemanagementResponseas
 	for _, asset := range allResponseAssets {
 		rc.SetCache(p.assetCache, *asset.Id, asset)
 	}
+	rc.SetCacheComplete(p.assetCache)
 
 	return &allResponseAssets, response, nil
 }
@@ -18,9 +18,8 @@ import (
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
 
-var (
-	dataSourceRoutingQueueCache *rc.DataSourceCache
-)
+// dataSourceRoutingQueueCaches holds one queue cache per configured provider so aliased providers don't share lookups
+var dataSourceRoutingQueueCaches = provider.NewProxyRegistry[rc.DataSourceCache]()
 
 func dataSourceRoutingQueueRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
@@ -28,9 +27,9 @@ func dataSourceRoutingQueueRead(ctx context.Context, d *schema.ResourceData, m i
 	key := d.Get("name").(string)
 	key = normalizeQueueName(key)
 
-	if dataSourceRoutingQueueCache == nil {
-		dataSourceRoutingQueueCache = rc.NewDataSourceCache(sdkConfig, hydrateRoutingQueueCacheFn, getQueueByNameFn)
-	}
+	dataSourceRoutingQueueCache := dataSourceRoutingQueueCaches.Get(sdkConfig, func(c *platformclientv2.Configuration) *rc.DataSourceCache {
+		return rc.NewDataSourceCache(c, hydrateRoutingQueueCacheFn, getQueueByNameFn)
+	})
 
 	queueId, err := rc.RetrieveId(dataSourceRoutingQueueCache, resourceName, key, ctx)
 
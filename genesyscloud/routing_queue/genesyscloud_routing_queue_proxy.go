@@ -3,6 +3,7 @@ package routing_queue
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
@@ -17,6 +18,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *RoutingQueueProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[RoutingQueueProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllRoutingQueuesFunc func(ctx context.Context, p *RoutingQueueProxy) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error)
 type getRoutingQueueByIdFunc func(ctx context.Context, p *RoutingQueueProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
@@ -35,7 +39,7 @@ type RoutingQueueProxy struct {
 // newRoutingQueuesProxy initializes the routing queue proxy with all the data needed to communicate with Genesys Cloud
 func newRoutingQueuesProxy(clientConfig *platformclientv2.Configuration) *RoutingQueueProxy {
 	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
-	routingQueueCache := rc.NewResourceCache[platformclientv2.Queue]()
+	routingQueueCache := rc.NewClientResourceCache[platformclientv2.Queue](clientConfig)
 
 	return &RoutingQueueProxy{
 		clientConfig:                     clientConfig,
@@ -47,13 +51,13 @@ func newRoutingQueuesProxy(clientConfig *platformclientv2.Configuration) *Routin
 	}
 }
 
-// GetRoutingQueueProxy acts as a singleton to for the internalProxy.  It also ensures
+// GetRoutingQueueProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func GetRoutingQueueProxy(clientConfig *platformclientv2.Configuration) *RoutingQueueProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingQueuesProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newRoutingQueuesProxy)
 }
 
 // GetAllRoutingQueues retrieves all Genesys Cloud routing queues
@@ -73,6 +77,10 @@ func (p *RoutingQueueProxy) getRoutingQueueWrapupCodeIds(ctx context.Context, qu
 
 // getAllRoutingQueuesFn is the implementation for retrieving all routing queues in Genesys Cloud
 func getAllRoutingQueuesFn(ctx context.Context, p *RoutingQueueProxy) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.RoutingQueueCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	var allQueues []platformclientv2.Queue
 	const pageSize = 100
 
@@ -87,7 +95,7 @@ func getAllRoutingQueuesFn(ctx context.Context, p *RoutingQueueProxy) (*[]platfo
 		return rc.GetCache(p.RoutingQueueCache), nil, nil
 	} else if rc.GetCacheSize(p.RoutingQueueCache) != *queues.Total && rc.GetCacheSize(p.RoutingQueueCache) != 0 {
 		// The cache is populated but not with the right data, clear the cache so it can be re populated
-		p.RoutingQueueCache = rc.NewResourceCache[platformclientv2.Queue]()
+		rc.ClearCache(p.RoutingQueueCache)
 	}
 
 	if queues.Entities == nil || len(*queues.Entities) == 0 {
@@ -112,6 +120,7 @@ func getAllRoutingQueuesFn(ctx context.Context, p *RoutingQueueProxy) (*[]platfo
 	for _, queue := range allQueues {
 		rc.SetCache(p.RoutingQueueCache, *queue.Id, queue)
 	}
+	rc.SetCacheComplete(p.RoutingQueueCache)
 
 	return &allQueues, resp, nil
 }
@@ -123,6 +132,16 @@ func getRoutingQueueByIdFn(ctx context.Context, p *RoutingQueueProxy, queueId st
 		return queue, nil, nil
 	}
 
+	queue = rc.GetBatchReadCacheItem(p.RoutingQueueCache, queueId, func() (*[]platformclientv2.Queue, error) {
+		queues, _, err := p.GetAllRoutingQueues(ctx)
+		return queues, err
+	}, func(queue platformclientv2.Queue) string {
+		return *queue.Id
+	})
+	if queue != nil {
+		return queue, nil, nil
+	}
+
 	queue, resp, err := p.routingApi.GetRoutingQueue(queueId)
 	if err != nil {
 		return nil, resp, fmt.Errorf("failed to retrieve routing queue by id %s: %s", queueId, err)
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
 	featureToggles "terraform-provider-genesyscloud/genesyscloud/util/feature_toggles"
@@ -121,6 +122,7 @@ func createQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(*queue.Id)
+	rc.DeleteCacheItem(GetRoutingQueueProxy(sdkConfig).RoutingQueueCache, d.Id())
 
 	diagErr := updateQueueMembers(d, sdkConfig)
 	if diagErr != nil {
@@ -317,6 +319,7 @@ func updateQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 
 	_, resp, err := routingAPI.PutRoutingQueue(d.Id(), updateQueue)
+	rc.DeleteCacheItem(GetRoutingQueueProxy(sdkConfig).RoutingQueueCache, d.Id())
 
 	if err != nil {
 		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to update queue %s error: %s", *updateQueue.Name, err), resp)
@@ -349,6 +352,7 @@ func deleteQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	log.Printf("Deleting queue %s", name)
 	resp, err := routingAPI.DeleteRoutingQueue(d.Id(), true)
+	rc.DeleteCacheItem(GetRoutingQueueProxy(sdkConfig).RoutingQueueCache, d.Id())
 	if err != nil {
 		return util.BuildAPIDiagnosticError(resourceName, fmt.Sprintf("Failed to delete queue %s error: %s", name, err), resp)
 	}
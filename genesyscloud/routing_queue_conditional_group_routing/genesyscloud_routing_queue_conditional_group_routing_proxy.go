@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 )
@@ -11,6 +12,9 @@ import (
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *routingQueueConditionalGroupRoutingProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[routingQueueConditionalGroupRoutingProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getRoutingQueueConditionRoutingFunc func(ctx context.Context, p *routingQueueConditionalGroupRoutingProxy, queueId string) (*[]platformclientv2.Conditionalgrouproutingrule, *platformclientv2.APIResponse, error)
 type updateRoutingQueueConditionRoutingFunc func(ctx context.Context, p *routingQueueConditionalGroupRoutingProxy, queueId string, rules *[]platformclientv2.Conditionalgrouproutingrule) (*[]platformclientv2.Conditionalgrouproutingrule, *platformclientv2.APIResponse, error)
@@ -40,11 +44,10 @@ func newRoutingQueueConditionalGroupRoutingProxy(clientConfig *platformclientv2.
 
 // getRoutingQueueConditionalGroupRoutingProxy retrieves all Genesys Cloud Routing queue conditional group routing
 func getRoutingQueueConditionalGroupRoutingProxy(clientConfig *platformclientv2.Configuration) *routingQueueConditionalGroupRoutingProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingQueueConditionalGroupRoutingProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newRoutingQueueConditionalGroupRoutingProxy)
 }
 
 // getRoutingQueueConditionRouting gets the conditional group routing rules for a queue
@@ -3,10 +3,14 @@ package routing_settings
 import (
 	"context"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 )
 
 var internalProxy *routingSettingsProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[routingSettingsProxy]()
+
 type getRoutingSettingsFunc func(ctx context.Context, p *routingSettingsProxy) (*platformclientv2.Routingsettings, *platformclientv2.APIResponse, error)
 type updateRoutingSettingsFunc func(ctx context.Context, p *routingSettingsProxy, routingSettings *platformclientv2.Routingsettings) (*platformclientv2.Routingsettings, *platformclientv2.APIResponse, error)
 type deleteRoutingSettingsFunc func(ctx context.Context, p *routingSettingsProxy) (*platformclientv2.APIResponse, error)
@@ -47,10 +51,10 @@ func newRoutingSettingsProxy(clientConfig *platformclientv2.Configuration) *rout
 }
 
 func getRoutingSettingsProxy(clientConfig *platformclientv2.Configuration) *routingSettingsProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingSettingsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newRoutingSettingsProxy)
 }
 
 func (p *routingSettingsProxy) getRoutingSettings(ctx context.Context) (*platformclientv2.Routingsettings, *platformclientv2.APIResponse, error) {
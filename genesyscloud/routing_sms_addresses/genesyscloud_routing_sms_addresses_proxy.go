@@ -3,6 +3,7 @@ package genesyscloud
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -27,6 +28,9 @@ type routingSmsAddressProxy struct {
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *routingSmsAddressProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[routingSmsAddressProxy]()
+
 // newRoutingSmsAddressProxy initializes the sms address proxy with all of the data needed to communicate with Genesys Cloud
 func newRoutingSmsAddressProxy(clientConfig *platformclientv2.Configuration) *routingSmsAddressProxy {
 	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
@@ -40,13 +44,13 @@ func newRoutingSmsAddressProxy(clientConfig *platformclientv2.Configuration) *ro
 	}
 }
 
-// getRoutingSmsAddressProxy acts as a singleton for the internalProxy.  It also ensures
+// getRoutingSmsAddressProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getRoutingSmsAddressProxy(clientConfig *platformclientv2.Configuration) *routingSmsAddressProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingSmsAddressProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newRoutingSmsAddressProxy)
 }
 
 // createSmsAddress creates a Genesys Cloud Sms Address
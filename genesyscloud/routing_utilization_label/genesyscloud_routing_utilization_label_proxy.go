@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
@@ -11,6 +12,9 @@ import (
 
 var internalProxy *routingUtilizationLabelProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[routingUtilizationLabelProxy]()
+
 type getAllRoutingUtilizationLabelsFunc func(ctx context.Context, p *routingUtilizationLabelProxy, name string) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error)
 type createRoutingUtilizationLabelFunc func(ctx context.Context, p *routingUtilizationLabelProxy, req *platformclientv2.Createutilizationlabelrequest) (*platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error)
 type getRoutingUtilizationLabelFunc func(ctx context.Context, p *routingUtilizationLabelProxy, id string) (*platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error)
@@ -32,7 +36,7 @@ type routingUtilizationLabelProxy struct {
 
 func newRoutingUtilizationLabelProxy(clientConfig *platformclientv2.Configuration) *routingUtilizationLabelProxy {
 	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
-	routingCache := rc.NewResourceCache[platformclientv2.Utilizationlabel]()
+	routingCache := rc.NewClientResourceCache[platformclientv2.Utilizationlabel](clientConfig)
 	return &routingUtilizationLabelProxy{
 		clientConfig:                         clientConfig,
 		routingApi:                           api,
@@ -47,10 +51,10 @@ func newRoutingUtilizationLabelProxy(clientConfig *platformclientv2.Configuratio
 }
 
 func getRoutingUtilizationLabelProxy(clientConfig *platformclientv2.Configuration) *routingUtilizationLabelProxy {
-	if internalProxy == nil {
-		internalProxy = newRoutingUtilizationLabelProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newRoutingUtilizationLabelProxy)
 }
 
 func (p *routingUtilizationLabelProxy) getAllRoutingUtilizationLabels(ctx context.Context, name string) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
@@ -78,6 +82,12 @@ func (p *routingUtilizationLabelProxy) deleteRoutingUtilizationLabel(ctx context
 }
 
 func getAllRoutingUtilizationLabelsFn(_ context.Context, p *routingUtilizationLabelProxy, name string) (*[]platformclientv2.Utilizationlabel, *platformclientv2.APIResponse, error) {
+	if name == "" {
+		if cached := rc.GetCompleteCache(p.routingCache); cached != nil {
+			return cached, nil, nil
+		}
+	}
+
 	var allUtilizationLabels []platformclientv2.Utilizationlabel
 	const pageSize = 100
 
@@ -106,6 +116,9 @@ func getAllRoutingUtilizationLabelsFn(_ context.Context, p *routingUtilizationLa
 	for _, label := range allUtilizationLabels {
 		rc.SetCache(p.routingCache, *label.Id, label)
 	}
+	if name == "" {
+		rc.SetCacheComplete(p.routingCache)
+	}
 
 	return &allUtilizationLabels, resp, nil
 }
@@ -127,7 +140,7 @@ func getRoutingUtilizationLabelByNameFn(ctx context.Context, p *routingUtilizati
 		return nil, false, resp, fmt.Errorf("error retrieving routing utilization label by name %s", err)
 	}
 
-	if labels == nil || len(*labels) == 0{
+	if labels == nil || len(*labels) == 0 {
 		return nil, true, resp, fmt.Errorf("no routing utilization labels found with name %s", name)
 	}
 
@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/http"
 	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
@@ -22,6 +23,9 @@ The genesyscloud_scripts_proxy.go file contains all of the logic associated with
 */
 var internalProxy *scriptsProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[scriptsProxy]()
+
 type createScriptFunc func(ctx context.Context, filePath, scriptName string, substitutions map[string]interface{}, p *scriptsProxy) (scriptId string, err error)
 type updateScriptFunc func(ctx context.Context, filePath, scriptName, scriptId string, substitutions map[string]interface{}, p *scriptsProxy) (id string, err error)
 type getAllPublishedScriptsFunc func(ctx context.Context, p *scriptsProxy) (*[]platformclientv2.Script, *platformclientv2.APIResponse, error)
@@ -56,19 +60,19 @@ type scriptsProxy struct {
 	scriptCache                       rc.CacheInterface[platformclientv2.Script]
 }
 
-// getScriptsProxy acts as a singleton to for the internalProxy.  It also ensures
+// getScriptsProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getScriptsProxy(clientConfig *platformclientv2.Configuration) *scriptsProxy {
-	if internalProxy == nil {
-		internalProxy = newScriptsProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newScriptsProxy)
 }
 
 // newScriptsProxy initializes the Scripts proxy with all of the data needed to communicate with Genesys Cloud
 func newScriptsProxy(clientConfig *platformclientv2.Configuration) *scriptsProxy {
 	scriptsAPI := platformclientv2.NewScriptsApiWithConfig(clientConfig)
-	scriptCache := rc.NewResourceCache[platformclientv2.Script]()
+	scriptCache := rc.NewClientResourceCache[platformclientv2.Script](clientConfig)
 	return &scriptsProxy{
 		clientConfig:                      clientConfig,
 		scriptsApi:                        scriptsAPI,
@@ -151,6 +155,10 @@ func publishScriptFn(_ context.Context, p *scriptsProxy, scriptId string) (*plat
 
 // getAllPublishedScriptsFn returns all published scripts within a Genesys Cloud instance
 func getAllPublishedScriptsFn(_ context.Context, p *scriptsProxy) (*[]platformclientv2.Script, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.scriptCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	var allScripts []platformclientv2.Script
 	var response *platformclientv2.APIResponse
 	pageSize := 50
@@ -192,6 +200,7 @@ func getAllPublishedScriptsFn(_ context.Context, p *scriptsProxy) (*[]platformcl
 	for _, script := range allScripts {
 		rc.SetCache(p.scriptCache, *script.Id, script)
 	}
+	rc.SetCacheComplete(p.scriptCache)
 
 	return &allScripts, response, nil
 }
@@ -3,6 +3,7 @@ package station
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -10,6 +11,9 @@ import (
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *stationProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[stationProxy]()
+
 type getStationIdByNameFunc func(ctx context.Context, p *stationProxy, stationName string) (stationId string, retryable bool, resp *platformclientv2.APIResponse, err error)
 
 // stationProxy contains all of the methods that call genesys cloud APIs.
@@ -30,13 +34,13 @@ func newStationProxy(clientConfig *platformclientv2.Configuration) *stationProxy
 	}
 }
 
-// getStationProxy acts as a singleton to for the internalProxy.  It also ensures
+// getStationProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getStationProxy(clientConfig *platformclientv2.Configuration) *stationProxy {
-	if internalProxy == nil {
-		internalProxy = newStationProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newStationProxy)
 }
 
 // getStationIdByName retrieves a Genesys Cloud Station ID by Name
@@ -3,6 +3,7 @@ package task_management_workbin
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -16,6 +17,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *taskManagementWorkbinProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[taskManagementWorkbinProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementWorkbinFunc func(ctx context.Context, p *taskManagementWorkbinProxy, workbin *platformclientv2.Workbincreate) (*platformclientv2.Workbin, *platformclientv2.APIResponse, error)
 type getAllTaskManagementWorkbinFunc func(ctx context.Context, p *taskManagementWorkbinProxy) (*[]platformclientv2.Workbin, *platformclientv2.APIResponse, error)
@@ -51,13 +55,13 @@ func newTaskManagementWorkbinProxy(clientConfig *platformclientv2.Configuration)
 	}
 }
 
-// getTaskManagementWorkbinProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementWorkbinProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementWorkbinProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorkbinProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorkbinProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newTaskManagementWorkbinProxy)
 }
 
 // createTaskManagementWorkbin creates a Genesys Cloud task management workbin
@@ -2,8 +2,8 @@ package task_management_workitem
 
 import (
 	"fmt"
-	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/cassette"
 	"testing"
 
 	workbin "terraform-provider-genesyscloud/genesyscloud/task_management_workbin"
@@ -84,7 +84,7 @@ func TestAccDataSourceTaskManagementWorkitem(t *testing.T) {
 	)
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { util.TestAccPreCheck(t) },
-		ProviderFactories: provider.GetProviderFactories(providerResources, providerDataSources),
+		ProviderFactories: cassette.ProviderFactories(t, providerResources, providerDataSources),
 		Steps: []resource.TestStep{
 			// Test with using workbin id filter. API requires either or both workbin and worktype id filters.
 			{
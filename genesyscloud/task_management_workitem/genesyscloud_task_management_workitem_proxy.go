@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -17,6 +18,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *taskManagementWorkitemProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[taskManagementWorkitemProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementWorkitemFunc func(ctx context.Context, p *taskManagementWorkitemProxy, workitem *platformclientv2.Workitemcreate) (*platformclientv2.Workitem, *platformclientv2.APIResponse, error)
 type getAllTaskManagementWorkitemFunc func(ctx context.Context, p *taskManagementWorkitemProxy) (*[]platformclientv2.Workitem, *platformclientv2.APIResponse, error)
@@ -52,13 +56,13 @@ func newTaskManagementWorkitemProxy(clientConfig *platformclientv2.Configuration
 	}
 }
 
-// getTaskManagementWorkitemProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementWorkitemProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementWorkitemProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorkitemProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorkitemProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newTaskManagementWorkitemProxy)
 }
 
 // createTaskManagementWorkitem creates a Genesys Cloud task management workitem
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -20,6 +21,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *taskManagementProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[taskManagementProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createTaskManagementWorkitemSchemaFunc func(ctx context.Context, p *taskManagementProxy, schema *platformclientv2.Dataschema) (*platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
 type getAllTaskManagementWorkitemSchemaFunc func(ctx context.Context, p *taskManagementProxy) (*[]platformclientv2.Dataschema, *platformclientv2.APIResponse, error)
@@ -58,13 +62,13 @@ func newTaskManagementProxy(clientConfig *platformclientv2.Configuration) *taskM
 	}
 }
 
-// getTaskManagementProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementProxy(clientConfig *platformclientv2.Configuration) *taskManagementProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newTaskManagementProxy)
 }
 
 // createTaskManagementWorkitemSchema creates a Genesys Cloud task management workitem schema
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -17,6 +18,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *taskManagementWorktypeProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[taskManagementWorktypeProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 
 type createTaskManagementWorktypeFunc func(ctx context.Context, p *taskManagementWorktypeProxy, worktype *platformclientv2.Worktypecreate) (*platformclientv2.Worktype, *platformclientv2.APIResponse, error)
@@ -67,13 +71,13 @@ func newTaskManagementWorktypeProxy(clientConfig *platformclientv2.Configuration
 	}
 }
 
-// getTaskManagementWorktypeProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTaskManagementWorktypeProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTaskManagementWorktypeProxy(clientConfig *platformclientv2.Configuration) *taskManagementWorktypeProxy {
-	if internalProxy == nil {
-		internalProxy = newTaskManagementWorktypeProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newTaskManagementWorktypeProxy)
 }
 
 // createTaskManagementWorktype creates a Genesys Cloud task management worktype
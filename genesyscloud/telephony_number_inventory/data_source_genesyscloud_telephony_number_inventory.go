@@ -0,0 +1,82 @@
+package telephony_number_inventory
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+// dataSourceTelephonyNumberInventoryRead lists the numbers of the DID pools
+func dataSourceTelephonyNumberInventoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*provider.ProviderMeta).ClientConfig
+	proxy := getTelephonyNumberInventoryProxy(sdkConfig)
+
+	var didPoolIds []string
+	if poolIds, ok := d.GetOk("did_pool_ids"); ok {
+		didPoolIds = *lists.SetToStringList(poolIds.(*schema.Set))
+	}
+	numberMatch := util.SanitizeE164Number(d.Get("number_match").(string))
+
+	log.Printf("Reading telephony number inventory")
+	numbers, resp, err := proxy.getAllDidNumbers(ctx, didPoolIds, numberMatch)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(dataSourceName, fmt.Sprintf("Failed to read telephony number inventory: %s", err), resp)
+	}
+
+	flattened := flattenDidNumbers(*numbers, d.Get("include_unassigned").(bool))
+	phoneNumbers := make([]string, 0, len(flattened))
+	for _, number := range flattened {
+		phoneNumbers = append(phoneNumbers, number["phone_number"].(string))
+	}
+	hash := sha256.Sum256([]byte(strings.Join(phoneNumbers, ",")))
+	d.SetId(hex.EncodeToString(hash[:]))
+	_ = d.Set("numbers", flattened)
+
+	log.Printf("Read %d numbers of the telephony number inventory", len(flattened))
+	return nil
+}
+
+// flattenDidNumbers flattens DID pool numbers sorted by phone number
+func flattenDidNumbers(numbers []platformclientv2.Didnumber, includeUnassigned bool) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(numbers))
+	for _, number := range numbers {
+		assigned := number.Assigned != nil && *number.Assigned
+		if number.Number == nil || (!assigned && !includeUnassigned) {
+			continue
+		}
+		numberMap := map[string]interface{}{
+			"phone_number": util.SanitizeE164Number(*number.Number),
+			"assigned":     assigned,
+		}
+		if number.DidPool != nil && number.DidPool.Id != nil {
+			numberMap["did_pool_id"] = *number.DidPool.Id
+		}
+		if number.OwnerType != nil {
+			numberMap["owner_type"] = *number.OwnerType
+		}
+		if number.Owner != nil {
+			if number.Owner.Id != nil {
+				numberMap["owner_id"] = *number.Owner.Id
+			}
+			if number.Owner.Name != nil {
+				numberMap["owner_name"] = *number.Owner.Name
+			}
+		}
+		flattened = append(flattened, numberMap)
+	}
+	sort.SliceStable(flattened, func(i, j int) bool {
+		return flattened[i]["phone_number"].(string) < flattened[j]["phone_number"].(string)
+	})
+	return flattened
+}
@@ -0,0 +1,60 @@
+package telephony_number_inventory
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDataSourceTelephonyNumberInventory(t *testing.T) {
+	didPoolId := uuid.NewString()
+	ivrId := uuid.NewString()
+	ivrName := "Support IVR"
+
+	numbers := []platformclientv2.Didnumber{
+		{Number: platformclientv2.String("+1 (317) 555-0102"), Assigned: platformclientv2.Bool(false), DidPool: &platformclientv2.Addressableentityref{Id: &didPoolId}},
+		{Number: platformclientv2.String("+13175550101"), Assigned: platformclientv2.Bool(true), DidPool: &platformclientv2.Addressableentityref{Id: &didPoolId},
+			Owner: &platformclientv2.Domainentityref{Id: &ivrId, Name: &ivrName}, OwnerType: platformclientv2.String("IVR_CONFIG")},
+	}
+
+	inventoryProxy := &telephonyNumberInventoryProxy{}
+	inventoryProxy.getAllDidNumbersAttr = func(ctx context.Context, p *telephonyNumberInventoryProxy, didPoolIds []string, numberMatch string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, []string{didPoolId}, didPoolIds)
+		assert.Equal(t, "+1317555", numberMatch)
+		return &numbers, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = inventoryProxy
+	defer func() { internalProxy = nil }()
+
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	resourceSchema := DataSourceTelephonyNumberInventory().Schema
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"did_pool_ids": []interface{}{didPoolId},
+		"number_match": "+1 (317) 555",
+	})
+	diagErr := dataSourceTelephonyNumberInventoryRead(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError())
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"phone_number": "+13175550101", "did_pool_id": didPoolId, "assigned": true, "owner_type": "IVR_CONFIG", "owner_id": ivrId, "owner_name": ivrName},
+		map[string]interface{}{"phone_number": "+13175550102", "did_pool_id": didPoolId, "assigned": false, "owner_type": "", "owner_id": "", "owner_name": ""},
+	}, d.Get("numbers"))
+
+	// Unassigned numbers are left out when include_unassigned is false
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"did_pool_ids":       []interface{}{didPoolId},
+		"number_match":       "+1317555",
+		"include_unassigned": false,
+	})
+	diagErr = dataSourceTelephonyNumberInventoryRead(context.Background(), d, gcloud)
+	assert.False(t, diagErr.HasError())
+	assert.Len(t, d.Get("numbers"), 1)
+	assert.Equal(t, "+13175550101", d.Get("numbers.0.phone_number"))
+}
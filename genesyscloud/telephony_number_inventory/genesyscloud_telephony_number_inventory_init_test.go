@@ -0,0 +1,40 @@
+package telephony_number_inventory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"sync"
+	"testing"
+)
+
+// providerDataSources holds a map of all registered data sources
+var providerDataSources map[string]*schema.Resource
+
+type registerTestInstance struct {
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	r.datasourceMapMutex.Lock()
+	defer r.datasourceMapMutex.Unlock()
+
+	providerDataSources[dataSourceName] = DataSourceTelephonyNumberInventory()
+}
+
+// initTestResources initializes all test data sources.
+func initTestResources() {
+	providerDataSources = make(map[string]*schema.Resource)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestDataSources()
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for package
+	initTestResources()
+
+	// Run the test suite for the package
+	m.Run()
+}
@@ -0,0 +1,89 @@
+package telephony_number_inventory
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+/*
+The genesyscloud_telephony_number_inventory_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+
+Each proxy implementation:
+
+1.  Should provide a private package level variable that holds a instance of a proxy class.
+2.  A New... constructor function to initialize the proxy object. This constructor should only be used within
+    the proxy.
+3.  A get private constructor function that the classes in the package can be used to retrieve
+    the proxy. This proxy should check to see if the package level proxy instance is nil and
+    should initialize it, otherwise it should return the instance
+4.  Type definitions for each function that will be used in the proxy.  We use composition here
+    so that we can easily provide mocks for testing.
+5.  A struct for the proxy that holds an attribute for each function type.
+6.  Wrapper methods on each of the elements on the struct.
+7.  Function implementations for each function type definition.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *telephonyNumberInventoryProxy
+
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[telephonyNumberInventoryProxy]()
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getAllDidNumbersFunc func(ctx context.Context, p *telephonyNumberInventoryProxy, didPoolIds []string, numberMatch string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error)
+
+// telephonyNumberInventoryProxy contains all of the methods that call genesys cloud APIs.
+type telephonyNumberInventoryProxy struct {
+	clientConfig         *platformclientv2.Configuration
+	telephonyApi         *platformclientv2.TelephonyProvidersEdgeApi
+	getAllDidNumbersAttr getAllDidNumbersFunc
+}
+
+// newTelephonyNumberInventoryProxy initializes the proxy with all data needed to communicate with Genesys Cloud
+func newTelephonyNumberInventoryProxy(clientConfig *platformclientv2.Configuration) *telephonyNumberInventoryProxy {
+	api := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig)
+	return &telephonyNumberInventoryProxy{
+		clientConfig:         clientConfig,
+		telephonyApi:         api,
+		getAllDidNumbersAttr: getAllDidNumbersFn,
+	}
+}
+
+// getTelephonyNumberInventoryProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getTelephonyNumberInventoryProxy(clientConfig *platformclientv2.Configuration) *telephonyNumberInventoryProxy {
+	if internalProxy != nil {
+		return internalProxy
+	}
+	return proxyRegistry.Get(clientConfig, newTelephonyNumberInventoryProxy)
+}
+
+// getAllDidNumbers retrieves the assigned and unassigned numbers of the DID pools
+func (p *telephonyNumberInventoryProxy) getAllDidNumbers(ctx context.Context, didPoolIds []string, numberMatch string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+	return p.getAllDidNumbersAttr(ctx, p, didPoolIds, numberMatch)
+}
+
+// getAllDidNumbersFn is an implementation function for retrieving the numbers of the DID pools
+func getAllDidNumbersFn(_ context.Context, p *telephonyNumberInventoryProxy, didPoolIds []string, numberMatch string) (*[]platformclientv2.Didnumber, *platformclientv2.APIResponse, error) {
+	var allNumbers []platformclientv2.Didnumber
+	const pageSize = 100
+
+	for pageNum := 1; ; pageNum++ {
+		numbers, resp, err := p.telephonyApi.GetTelephonyProvidersEdgesDidpoolsDids("ASSIGNED_AND_UNASSIGNED", didPoolIds, numberMatch, pageSize, pageNum, "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("error requesting page of DID pool numbers: %v", err)
+		}
+		if numbers.Entities == nil || len(*numbers.Entities) == 0 {
+			return &allNumbers, resp, nil
+		}
+		allNumbers = append(allNumbers, *numbers.Entities...)
+		if numbers.PageCount == nil || pageNum >= *numbers.PageCount {
+			return &allNumbers, resp, nil
+		}
+	}
+}
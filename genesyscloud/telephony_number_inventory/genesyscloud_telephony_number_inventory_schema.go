@@ -0,0 +1,82 @@
+package telephony_number_inventory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+)
+
+const dataSourceName = "genesyscloud_telephony_number_inventory"
+
+// SetRegistrar registers all resources, data sources and exporters in the package
+func SetRegistrar(l registrar.Registrar) {
+	l.RegisterDataSource(dataSourceName, DataSourceTelephonyNumberInventory())
+}
+
+var didNumberResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"phone_number": {
+			Description: "Phone number in an E.164 number format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"did_pool_id": {
+			Description: "ID of the DID pool the number belongs to.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"assigned": {
+			Description: "Whether the number is assigned.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"owner_type": {
+			Description: "Type of the owner of the number, e.g. IVR_CONFIG, USER or PHONE. Empty when the number is not assigned.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"owner_id": {
+			Description: "ID of the IVR, user or phone the number is assigned to.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"owner_name": {
+			Description: "Name of the IVR, user or phone the number is assigned to.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceTelephonyNumberInventory registers the genesyscloud_telephony_number_inventory data source
+func DataSourceTelephonyNumberInventory() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the inventory of Genesys Cloud DIDs. Lists the numbers of the DID pools with the pool they belong to and the IVR, user or phone they are assigned to.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceTelephonyNumberInventoryRead),
+		Schema: map[string]*schema.Schema{
+			"did_pool_ids": {
+				Description: "IDs of the DID pools to list the numbers of. All the DID pools are listed when not set.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"number_match": {
+				Description: "Only lists the numbers matching this number. Formatting characters such as spaces, hyphens and parentheses are ignored.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"include_unassigned": {
+				Description: "Whether the numbers that are not assigned are listed. Large DID pools can have many unassigned numbers.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"numbers": {
+				Description: "Numbers of the DID pools.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        didNumberResource,
+			},
+		},
+	}
+}
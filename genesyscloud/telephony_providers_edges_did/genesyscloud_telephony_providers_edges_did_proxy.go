@@ -3,6 +3,7 @@ package telephony_providers_edges_did
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -31,6 +32,9 @@ Each proxy implementation:
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *telephonyProvidersEdgesDidProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[telephonyProvidersEdgesDidProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getTelephonyProvidersEdgesDidIdByDidFunc func(ctx context.Context, t *telephonyProvidersEdgesDidProxy, did string) (id string, retryable bool, resp *platformclientv2.APIResponse, err error)
 
@@ -51,13 +55,13 @@ func newTelephonyProvidersEdgesDidProxy(clientConfig *platformclientv2.Configura
 	}
 }
 
-// getTelephonyProvidersEdgesDidProxy acts as a singleton for the internalProxy.  It also ensures
+// getTelephonyProvidersEdgesDidProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTelephonyProvidersEdgesDidProxy(clientConfig *platformclientv2.Configuration) *telephonyProvidersEdgesDidProxy {
-	if internalProxy == nil {
-		internalProxy = newTelephonyProvidersEdgesDidProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newTelephonyProvidersEdgesDidProxy)
 }
 
 // getTelephonyProvidersEdgesDidIdByDid gets a Genesys Cloud telephony DID ID by DID number
@@ -3,6 +3,7 @@ package telephony_providers_edges_did_pool
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -31,6 +32,9 @@ Each proxy implementation:
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *telephonyDidPoolProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[telephonyDidPoolProxy]()
+
 // Type definitions for each func on our proxy, so we can easily mock them out later
 type createTelephonyDidPool func(ctx context.Context, t *telephonyDidPoolProxy, didPool *platformclientv2.Didpool) (*platformclientv2.Didpool, *platformclientv2.APIResponse, error)
 type getTelephonyDidPoolById func(context.Context, *telephonyDidPoolProxy, string) (didPool *platformclientv2.Didpool, resp *platformclientv2.APIResponse, err error)
@@ -66,13 +70,13 @@ func newTelephonyProvidersEdgesDidPoolProxy(clientConfig *platformclientv2.Confi
 	}
 }
 
-// getTelephonyDidPoolProxy acts as a singleton for the internalProxy. It also ensures
+// getTelephonyDidPoolProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTelephonyDidPoolProxy(clientConfig *platformclientv2.Configuration) *telephonyDidPoolProxy {
-	if internalProxy == nil {
-		internalProxy = newTelephonyProvidersEdgesDidPoolProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newTelephonyProvidersEdgesDidPoolProxy)
 }
 
 // createTelephonyDidPool creates a Genesys Cloud did pool
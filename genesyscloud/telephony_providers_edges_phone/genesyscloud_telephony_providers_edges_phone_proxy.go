@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
@@ -35,6 +36,9 @@ Each proxy implementation:
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *phoneProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[phoneProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllPhonesFunc func(ctx context.Context, p *phoneProxy) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error)
 type createPhoneFunc func(ctx context.Context, p *phoneProxy, phoneConfig *platformclientv2.Phone) (*platformclientv2.Phone, *platformclientv2.APIResponse, error)
@@ -76,7 +80,7 @@ func newPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
 	edgesApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig)
 	stationsApi := platformclientv2.NewStationsApiWithConfig(clientConfig)
 	usersApi := platformclientv2.NewUsersApiWithConfig(clientConfig)
-	phoneCache := rc.NewResourceCache[platformclientv2.Phone]()
+	phoneCache := rc.NewClientResourceCache[platformclientv2.Phone](clientConfig)
 
 	return &phoneProxy{
 		clientConfig: clientConfig,
@@ -100,13 +104,13 @@ func newPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
 	}
 }
 
-// getPhoneProxy acts as a singleton to for the internalProxy.  It also ensures
+// getPhoneProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getPhoneProxy(clientConfig *platformclientv2.Configuration) *phoneProxy {
-	if internalProxy == nil {
-		internalProxy = newPhoneProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newPhoneProxy)
 }
 
 // getAllPhones retrieves all Genesys Cloud Phones
@@ -169,6 +173,10 @@ func (p *phoneProxy) assignStationAsDefault(ctx context.Context, userId string,
 
 // getAllPhonesFn is an implementation function for retrieving all Genesys Cloud Phones
 func getAllPhonesFn(ctx context.Context, p *phoneProxy) (*[]platformclientv2.Phone, *platformclientv2.APIResponse, error) {
+	if cached := rc.GetCompleteCache(p.phoneCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	log.Printf("Entering the getAllPhonesFn method to retrieve all of the phone ids for export")
 	var allPhones []platformclientv2.Phone
 	const pageSize = 100
@@ -217,6 +225,7 @@ func getAllPhonesFn(ctx context.Context, p *phoneProxy) (*[]platformclientv2.Pho
 		// Cache the phone resource into the p.phoneCache for later use
 		rc.SetCache(p.phoneCache, *phone.Id, phone)
 	}
+	rc.SetCacheComplete(p.phoneCache)
 
 	return &allPhones, response, nil
 }
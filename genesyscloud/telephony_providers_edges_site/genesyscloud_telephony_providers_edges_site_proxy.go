@@ -3,6 +3,7 @@ package telephony_providers_edges_site
 import (
 	"context"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
@@ -32,6 +33,9 @@ Each proxy implementation:
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *SiteProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[SiteProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getAllSitesFunc func(ctx context.Context, p *SiteProxy, managed bool) (*[]platformclientv2.Site, *platformclientv2.APIResponse, error)
 type createSiteFunc func(ctx context.Context, p *SiteProxy, site *platformclientv2.Site) (*platformclientv2.Site, *platformclientv2.APIResponse, error)
@@ -92,8 +96,8 @@ func newSiteProxy(clientConfig *platformclientv2.Configuration) *SiteProxy {
 	telephonyApi := platformclientv2.NewTelephonyApiWithConfig(clientConfig)
 	organizationApi := platformclientv2.NewOrganizationApiWithConfig(clientConfig)
 
-	unmanagedSiteCache := rc.NewResourceCache[platformclientv2.Site]()
-	managedSiteCache := rc.NewResourceCache[platformclientv2.Site]()
+	unmanagedSiteCache := rc.NewNamedClientResourceCache[platformclientv2.Site](clientConfig, "unmanaged_sites")
+	managedSiteCache := rc.NewNamedClientResourceCache[platformclientv2.Site](clientConfig, "managed_sites")
 
 	return &SiteProxy{
 		clientConfig:    clientConfig,
@@ -127,13 +131,13 @@ func newSiteProxy(clientConfig *platformclientv2.Configuration) *SiteProxy {
 	}
 }
 
-// GetSiteProxy acts as a singleton for the internalProxy.  It also ensures
+// GetSiteProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func GetSiteProxy(clientConfig *platformclientv2.Configuration) *SiteProxy {
-	if internalProxy == nil {
-		internalProxy = newSiteProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newSiteProxy)
 }
 
 // GetAllSites retrieves all managed Genesys Cloud Sites
@@ -230,6 +234,10 @@ func getAllSitesFn(ctx context.Context, p *SiteProxy, managed bool) (*[]platform
 		break
 	}
 
+	if cached := rc.GetCompleteCache(siteCache); cached != nil {
+		return cached, nil, nil
+	}
+
 	const pageSize = 100
 	sites, resp, err := p.edgesApi.GetTelephonyProvidersEdgesSites(pageSize, 1, "", "", "", "", managed, nil)
 	if err != nil {
@@ -249,7 +257,7 @@ func getAllSitesFn(ctx context.Context, p *SiteProxy, managed bool) (*[]platform
 		return rc.GetCache(siteCache), nil, nil
 	} else if rc.GetCacheSize(siteCache) != *sites.Total && rc.GetCacheSize(siteCache) != 0 {
 		// The cache is populated but not with the right data, clear the cache so it can be re populated
-		siteCache = rc.NewResourceCache[platformclientv2.Site]()
+		rc.ClearCache(siteCache)
 	}
 
 	for pageNum := 2; pageNum <= *sites.PageCount; pageNum++ {
@@ -273,6 +281,7 @@ func getAllSitesFn(ctx context.Context, p *SiteProxy, managed bool) (*[]platform
 	for _, site := range allSites {
 		rc.SetCache(siteCache, *site.Id, site)
 	}
+	rc.SetCacheComplete(siteCache)
 
 	return &allSites, resp, nil
 }
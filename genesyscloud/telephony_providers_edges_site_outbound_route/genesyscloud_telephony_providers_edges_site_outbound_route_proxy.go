@@ -3,6 +3,7 @@ package telephony_providers_edges_site_outbound_route
 import (
 	"context"
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	telephonyProvidersEdgesSite "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_site"
 )
@@ -16,6 +17,9 @@ out during testing.
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *siteOutboundRoutesProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[siteOutboundRoutesProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 type getSiteFunc func(ctx context.Context, p *siteOutboundRoutesProxy, siteId string) (*platformclientv2.Site, *platformclientv2.APIResponse, error)
 type createSiteOutboundRouteFunc func(ctx context.Context, p *siteOutboundRoutesProxy, siteId string, outboundRoute *platformclientv2.Outboundroutebase) (*platformclientv2.Outboundroutebase, *platformclientv2.APIResponse, error)
@@ -41,7 +45,7 @@ type siteOutboundRoutesProxy struct {
 func newSiteOutboundRoutesProxy(clientConfig *platformclientv2.Configuration) *siteOutboundRoutesProxy {
 	edgesApi := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(clientConfig)
 	siteProxy := telephonyProvidersEdgesSite.GetSiteProxy(clientConfig)
-	siteOutboundRouteCache := rc.NewResourceCache[[]platformclientv2.Outboundroutebase]()
+	siteOutboundRouteCache := rc.NewClientResourceCache[[]platformclientv2.Outboundroutebase](clientConfig)
 
 	return &siteOutboundRoutesProxy{
 		clientConfig: clientConfig,
@@ -57,13 +61,13 @@ func newSiteOutboundRoutesProxy(clientConfig *platformclientv2.Configuration) *s
 	}
 }
 
-// getSiteOutboundRouteProxy acts as a singleton for the internalProxy.  It also ensures
+// getSiteOutboundRouteProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getSiteOutboundRouteProxy(clientConfig *platformclientv2.Configuration) *siteOutboundRoutesProxy {
-	if internalProxy == nil {
-		internalProxy = newSiteOutboundRoutesProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newSiteOutboundRoutesProxy)
 }
 
 func (p *siteOutboundRoutesProxy) getSite(ctx context.Context, id string) (*platformclientv2.Site, *platformclientv2.APIResponse, error) {
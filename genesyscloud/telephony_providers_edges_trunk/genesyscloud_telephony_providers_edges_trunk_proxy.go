@@ -2,6 +2,7 @@ package telephony_providers_edges_trunk
 
 import (
 	"context"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
 )
@@ -11,6 +12,9 @@ import (
 // internalProxy holds a proxy instance that can be used throughout the package
 var internalProxy *trunkProxy
 
+// proxyRegistry holds one proxy instance per configured provider
+var proxyRegistry = provider.NewProxyRegistry[trunkProxy]()
+
 // Type definitions for each func on our proxy so we can easily mock them out later
 
 type getTrunkByIdFunc func(ctx context.Context, p *trunkProxy, id string) (*platformclientv2.Trunk, *platformclientv2.APIResponse, error)
@@ -51,14 +55,13 @@ func newTrunkProxy(clientConfig *platformclientv2.Configuration) *trunkProxy {
 	}
 }
 
-// getTeamProxy acts as a singleton to for the internalProxy.  It also ensures
+// getTeamProxy returns the proxy for the provider instance owning clientConfig.  It also ensures
 // that we can still proxy our tests by directly setting internalProxy package variable
 func getTrunkProxy(clientConfig *platformclientv2.Configuration) *trunkProxy {
-	if internalProxy == nil {
-		internalProxy = newTrunkProxy(clientConfig)
+	if internalProxy != nil {
+		return internalProxy
 	}
-
-	return internalProxy
+	return proxyRegistry.Get(clientConfig, newTrunkProxy)
 }
 
 func (p *trunkProxy) getEdge(ctx context.Context, edgeId string) (*platformclientv2.Edge, *platformclientv2.APIResponse, error) {
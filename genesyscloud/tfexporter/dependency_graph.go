@@ -0,0 +1,295 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/*
+This file contains the dependency graph of an export. When dependency_graph_format is set, every exported resource is
+a node of the graph, and every reference from the config of a resource to another exported resource or data source is
+an edge, including the depends_on references added for flows. Edges between the flows of CyclicDependsList are marked
+as cyclic.
+*/
+const (
+	dependencyGraphDot     = "dot"
+	dependencyGraphMermaid = "mermaid"
+	dependencyGraphJson    = "json"
+
+	defaultDependencyGraphFile = "dependency_graph"
+)
+
+var dependencyGraphFileExtensions = map[string]string{
+	dependencyGraphDot:     ".dot",
+	dependencyGraphMermaid: ".mmd",
+	dependencyGraphJson:    ".json",
+}
+
+var (
+	// Matches the reference expressions set by resolveReference, e.g. ${genesyscloud_routing_queue.support.id}
+	graphReferenceRegex = regexp.MustCompile(`\$\{((?:data\.)?genesyscloud_[a-z0-9_]+\.[A-Za-z0-9_-]+)\.[a-z0-9_]+\}`)
+
+	// Matches the depends_on values set by addDependsOnValues, e.g. $dep$genesyscloud_flow.main$dep$
+	graphDependsOnRegex = regexp.MustCompile(`\$dep\$(genesyscloud_[a-z0-9_]+\.[A-Za-z0-9_-]+)\$dep\$`)
+)
+
+type dependencyGraph struct {
+	Nodes []*dependencyGraphNode `json:"nodes"`
+	Edges []*dependencyGraphEdge `json:"edges"`
+}
+
+type dependencyGraphNode struct {
+	// Address of the resource in the exported config, e.g. genesyscloud_routing_queue.support
+	Address    string `json:"address"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	Id         string `json:"id,omitempty"`
+	DataSource bool   `json:"data_source,omitempty"`
+}
+
+type dependencyGraphEdge struct {
+	// Address of the resource that references the other one
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	// Attributes of the From resource holding the reference, without list indexes
+	Attributes []string `json:"attributes"`
+	Cyclic     bool     `json:"cyclic,omitempty"`
+}
+
+// writeDependencyGraph writes the dependency graph of the exported resources to the export directory in the format
+// set by dependency_graph_format
+func (g *GenesysCloudResourceExporter) writeDependencyGraph() diag.Diagnostics {
+	format := g.d.Get("dependency_graph_format").(string)
+	if format == "" {
+		return nil
+	}
+
+	graph := g.buildDependencyGraph()
+	var data []byte
+	switch format {
+	case dependencyGraphDot:
+		data = []byte(graph.dot())
+	case dependencyGraphMermaid:
+		data = []byte(graph.mermaid())
+	case dependencyGraphJson:
+		jsonData, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return diag.Errorf("Failed to encode dependency graph as JSON: %v", err)
+		}
+		data = jsonData
+	default:
+		return diag.Errorf("Unknown dependency graph format %s", format)
+	}
+
+	log.Printf("Dependency graph: %d resources and %d references", len(graph.Nodes), len(graph.Edges))
+	return files.WriteToFile(data, filepath.Join(g.exportDirPath, defaultDependencyGraphFile+dependencyGraphFileExtensions[format]))
+}
+
+// buildDependencyGraph collects the references between the resources of the config maps built by buildResourceConfigMap
+func (g *GenesysCloudResourceExporter) buildDependencyGraph() *dependencyGraph {
+	graph := &dependencyGraph{}
+	nodes := make(map[string]*dependencyGraphNode)
+	addNode := func(node *dependencyGraphNode) {
+		if _, ok := nodes[node.Address]; !ok {
+			nodes[node.Address] = node
+			graph.Nodes = append(graph.Nodes, node)
+		}
+	}
+	// Resources with the same name were renamed when their config was built, so the names are taken from the config maps
+	ids := make(map[string]string)
+	for _, resource := range g.resources {
+		ids[resource.Type+"."+resource.Name] = resource.State.ID
+	}
+	for resType, configMaps := range g.resourceTypesMaps {
+		for resName := range configMaps {
+			addNode(&dependencyGraphNode{Address: resType + "." + resName, Type: resType, Name: resName, Id: ids[resType+"."+resName]})
+		}
+	}
+	for resType, configMaps := range g.dataSourceTypesMaps {
+		for resName := range configMaps {
+			addNode(&dependencyGraphNode{Address: "data." + resType + "." + resName, Type: resType, Name: resName, Id: ids[resType+"."+resName], DataSource: true})
+		}
+	}
+
+	edges := make(map[string]*dependencyGraphEdge)
+	addEdge := func(from string, to string, attribute string) *dependencyGraphEdge {
+		key := from + " -> " + to
+		edge, ok := edges[key]
+		if !ok {
+			edge = &dependencyGraphEdge{From: from, To: to, Attributes: make([]string, 0)}
+			edges[key] = edge
+			graph.Edges = append(graph.Edges, edge)
+		}
+		if attribute != "" && !lists.ItemInSlice(attribute, edge.Attributes) {
+			edge.Attributes = append(edge.Attributes, attribute)
+		}
+		return edge
+	}
+
+	for resType, configMaps := range g.resourceTypesMaps {
+		for resName, configMap := range configMaps {
+			from := resType + "." + resName
+			walkGraphReferences(configMap, "", func(attribute string, to string) {
+				if to != from && nodes[to] != nil {
+					addEdge(from, to, attribute)
+				}
+			})
+		}
+	}
+
+	// The cyclic dependencies are listed as "genesyscloud_flow.<flow name> , genesyscloud_flow.<resource name>"
+	sanitizer := resourceExporter.NewSanitizerProvider()
+	findNode := func(label string) string {
+		label = strings.TrimSpace(label)
+		if nodes[label] != nil {
+			return label
+		}
+		if resType, name, found := strings.Cut(label, "."); found {
+			if address := resType + "." + sanitizer.S.SanitizeResourceName(name); nodes[address] != nil {
+				return address
+			}
+			addNode(&dependencyGraphNode{Address: label, Type: resType, Name: name})
+		}
+		return label
+	}
+	for _, cyclicDepends := range g.cyclicDependsList {
+		dependency, dependent, found := strings.Cut(cyclicDepends, ",")
+		if !found {
+			continue
+		}
+		from, to := findNode(dependent), findNode(dependency)
+		addEdge(from, to, "").Cyclic = true
+		if edge, ok := edges[to+" -> "+from]; ok {
+			edge.Cyclic = true
+		}
+	}
+
+	graph.sort()
+	return graph
+}
+
+// walkGraphReferences calls found for every reference to another resource in a config value
+func walkGraphReferences(value interface{}, attribute string, found func(attribute string, address string)) {
+	switch v := value.(type) {
+	case util.JsonMap:
+		walkGraphReferences(map[string]interface{}(v), attribute, found)
+	case map[string]interface{}:
+		for key, val := range v {
+			walkGraphReferences(val, joinGraphAttribute(attribute, key), found)
+		}
+	case []interface{}:
+		for _, val := range v {
+			walkGraphReferences(val, attribute, found)
+		}
+	case []string:
+		for _, val := range v {
+			walkGraphReferences(val, attribute, found)
+		}
+	case string:
+		// JSON encoded attributes are replaced by a placeholder when exporting HCL
+		if decoded, ok := attributesDecoded[v]; ok {
+			v = decoded
+		}
+		for _, match := range graphReferenceRegex.FindAllStringSubmatch(v, -1) {
+			found(attribute, match[1])
+		}
+		for _, match := range graphDependsOnRegex.FindAllStringSubmatch(v, -1) {
+			found(attribute, match[1])
+		}
+	}
+}
+
+func joinGraphAttribute(attribute string, key string) string {
+	if attribute == "" {
+		return key
+	}
+	return attribute + "." + key
+}
+
+func (graph *dependencyGraph) sort() {
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].Address < graph.Nodes[j].Address
+	})
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		return graph.Edges[i].To < graph.Edges[j].To
+	})
+	for _, edge := range graph.Edges {
+		sort.Strings(edge.Attributes)
+	}
+}
+
+// dot returns the graph in the Graphviz DOT language. Data sources are dashed and cyclic references are red.
+func (graph *dependencyGraph) dot() string {
+	var sb strings.Builder
+	sb.WriteString("digraph dependencies {\n  rankdir=LR;\n  node [shape=box];\n")
+	for _, node := range graph.Nodes {
+		if node.DataSource {
+			sb.WriteString(fmt.Sprintf("  %s [style=dashed];\n", strconv.Quote(node.Address)))
+		} else {
+			sb.WriteString(fmt.Sprintf("  %s;\n", strconv.Quote(node.Address)))
+		}
+	}
+	for _, edge := range graph.Edges {
+		attributes := []string{fmt.Sprintf("label=%s", strconv.Quote(strings.Join(edge.Attributes, ", ")))}
+		if edge.Cyclic {
+			attributes = append(attributes, "color=red", "fontcolor=red")
+		}
+		sb.WriteString(fmt.Sprintf("  %s -> %s [%s];\n", strconv.Quote(edge.From), strconv.Quote(edge.To), strings.Join(attributes, ", ")))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// mermaid returns the graph as a Mermaid flowchart. Data sources are dashed and cyclic references are red.
+func (graph *dependencyGraph) mermaid() string {
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	ids := make(map[string]string)
+	for i, node := range graph.Nodes {
+		ids[node.Address] = fmt.Sprintf("n%d", i)
+		sb.WriteString(fmt.Sprintf("  %s[\"%s\"]", ids[node.Address], mermaidText(node.Address)))
+		if node.DataSource {
+			sb.WriteString(":::dataSource")
+		}
+		sb.WriteString("\n")
+	}
+
+	var cyclicLinks []string
+	for i, edge := range graph.Edges {
+		if len(edge.Attributes) > 0 {
+			sb.WriteString(fmt.Sprintf("  %s -->|\"%s\"| %s\n", ids[edge.From], mermaidText(strings.Join(edge.Attributes, ", ")), ids[edge.To]))
+		} else {
+			sb.WriteString(fmt.Sprintf("  %s --> %s\n", ids[edge.From], ids[edge.To]))
+		}
+		if edge.Cyclic {
+			cyclicLinks = append(cyclicLinks, strconv.Itoa(i))
+		}
+	}
+
+	sb.WriteString("  classDef dataSource stroke-dasharray: 5 5\n")
+	if len(cyclicLinks) > 0 {
+		sb.WriteString(fmt.Sprintf("  linkStyle %s stroke:red,color:red\n", strings.Join(cyclicLinks, ",")))
+	}
+	return sb.String()
+}
+
+func mermaidText(text string) string {
+	return strings.ReplaceAll(text, "\"", "#quot;")
+}
@@ -0,0 +1,119 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"testing"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportBuildDependencyGraph(t *testing.T) {
+	gre := &GenesysCloudResourceExporter{
+		resources: []resourceExporter.ResourceInfo{
+			{Type: "genesyscloud_flow", Name: "main", State: &terraform.InstanceState{ID: "flow-1"}},
+			{Type: "genesyscloud_flow", Name: "transfer", State: &terraform.InstanceState{ID: "flow-2"}},
+			{Type: "genesyscloud_routing_queue", Name: "support", State: &terraform.InstanceState{ID: "queue-1"}},
+		},
+		resourceTypesMaps: map[string]resourceJSONMaps{
+			"genesyscloud_flow": {
+				"main": util.JsonMap{
+					"name":       "Main",
+					"depends_on": []string{"$dep$genesyscloud_flow.transfer$dep$", "$dep$genesyscloud_routing_queue.support$dep$"},
+				},
+				"transfer": util.JsonMap{"depends_on": []string{"$dep$genesyscloud_flow.main$dep$"}},
+			},
+			"genesyscloud_routing_queue": {
+				"support": util.JsonMap{
+					"queue_flow_id": "${genesyscloud_flow.main.id}",
+					"members": []interface{}{
+						map[string]interface{}{"user_id": "${data.genesyscloud_user.agent.id}"},
+						map[string]interface{}{"user_id": "${data.genesyscloud_user.agent.id}"},
+					},
+					// References to resources outside the export are not edges
+					"whisper_prompt_id": "${genesyscloud_architect_user_prompt.missing.id}",
+				},
+			},
+		},
+		dataSourceTypesMaps: map[string]resourceJSONMaps{
+			"genesyscloud_user": {"agent": util.JsonMap{"email": "agent@example.com"}},
+		},
+		cyclicDependsList: []string{"genesyscloud_flow.transfer , genesyscloud_flow.main"},
+	}
+
+	graph := gre.buildDependencyGraph()
+	assert.Equal(t, []*dependencyGraphNode{
+		{Address: "data.genesyscloud_user.agent", Type: "genesyscloud_user", Name: "agent", DataSource: true},
+		{Address: "genesyscloud_flow.main", Type: "genesyscloud_flow", Name: "main", Id: "flow-1"},
+		{Address: "genesyscloud_flow.transfer", Type: "genesyscloud_flow", Name: "transfer", Id: "flow-2"},
+		{Address: "genesyscloud_routing_queue.support", Type: "genesyscloud_routing_queue", Name: "support", Id: "queue-1"},
+	}, graph.Nodes)
+	assert.Equal(t, []*dependencyGraphEdge{
+		{From: "genesyscloud_flow.main", To: "genesyscloud_flow.transfer", Attributes: []string{"depends_on"}, Cyclic: true},
+		{From: "genesyscloud_flow.main", To: "genesyscloud_routing_queue.support", Attributes: []string{"depends_on"}},
+		{From: "genesyscloud_flow.transfer", To: "genesyscloud_flow.main", Attributes: []string{"depends_on"}, Cyclic: true},
+		{From: "genesyscloud_routing_queue.support", To: "data.genesyscloud_user.agent", Attributes: []string{"members.user_id"}},
+		{From: "genesyscloud_routing_queue.support", To: "genesyscloud_flow.main", Attributes: []string{"queue_flow_id"}},
+	}, graph.Edges)
+}
+
+func TestUnitTfExportDependencyGraphFormats(t *testing.T) {
+	graph := &dependencyGraph{
+		Nodes: []*dependencyGraphNode{
+			{Address: "data.genesyscloud_user.agent", DataSource: true},
+			{Address: "genesyscloud_flow.main"},
+			{Address: "genesyscloud_routing_queue.support"},
+		},
+		Edges: []*dependencyGraphEdge{
+			{From: "genesyscloud_flow.main", To: "genesyscloud_routing_queue.support", Attributes: []string{}, Cyclic: true},
+			{From: "genesyscloud_routing_queue.support", To: "data.genesyscloud_user.agent", Attributes: []string{"members.user_id"}},
+		},
+	}
+
+	assert.Equal(t, `digraph dependencies {
+  rankdir=LR;
+  node [shape=box];
+  "data.genesyscloud_user.agent" [style=dashed];
+  "genesyscloud_flow.main";
+  "genesyscloud_routing_queue.support";
+  "genesyscloud_flow.main" -> "genesyscloud_routing_queue.support" [label="", color=red, fontcolor=red];
+  "genesyscloud_routing_queue.support" -> "data.genesyscloud_user.agent" [label="members.user_id"];
+}
+`, graph.dot())
+
+	assert.Equal(t, `flowchart LR
+  n0["data.genesyscloud_user.agent"]:::dataSource
+  n1["genesyscloud_flow.main"]
+  n2["genesyscloud_routing_queue.support"]
+  n1 --> n2
+  n2 -->|"members.user_id"| n0
+  classDef dataSource stroke-dasharray: 5 5
+  linkStyle 0 stroke:red,color:red
+`, graph.mermaid())
+}
+
+func TestUnitTfExportDependencyGraphWithModules(t *testing.T) {
+	m := testModuleExporter(t, moduleGroupingResourceType, nil)
+	exporters := m.exporters
+	gre := &GenesysCloudResourceExporter{
+		d:                   schema.TestResourceDataRaw(t, ResourceTfExport().Schema, map[string]interface{}{"dependency_graph_format": dependencyGraphJson}),
+		version:             "1.0.0",
+		exportDirPath:       m.dirPath,
+		modularizeBy:        moduleGroupingResourceType,
+		exporters:           &exporters,
+		resourceTypesMaps:   m.resourceTypesJSONMaps,
+		dataSourceTypesMaps: m.dataSourceTypesMaps,
+	}
+	gre.setupManifest()
+	assert.Nil(t, gre.generateOutputFiles())
+
+	// The references rewritten to module variables are still edges of the graph
+	graph := dependencyGraph{}
+	assert.Nil(t, json.Unmarshal([]byte(readExportFile(t, m.dirPath, defaultDependencyGraphFile+".json")), &graph))
+	assert.Contains(t, graph.Edges, &dependencyGraphEdge{From: "genesyscloud_routing_queue.support", To: "genesyscloud_auth_division.sales", Attributes: []string{"division_id"}})
+	assert.Contains(t, graph.Edges, &dependencyGraphEdge{From: "genesyscloud_routing_queue.support", To: "genesyscloud_routing_wrapupcode.done", Attributes: []string{"wrapup_codes"}})
+}
@@ -0,0 +1,354 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains the drift report of an export. When drift_state_file is set, every exported resource is compared
+against the resources of an existing Terraform state file. Both sides are run through sanitizeConfigMap, so the
+comparison ignores the zero values and excluded attributes that the exporter drops from the config.
+*/
+const (
+	defaultDriftReportJSONFile     = "drift_report.json"
+	defaultDriftReportMarkdownFile = "drift_report.md"
+)
+
+type driftReport struct {
+	StateFile string `json:"state_file"`
+
+	// Resources that exist in the org but are not managed by the state
+	Unmanaged []driftResource `json:"unmanaged"`
+
+	// Resources that are in the state but no longer exist in the org
+	Deleted []driftResource `json:"deleted"`
+
+	// Resources whose attributes in the org differ from the state
+	Changed []driftResource `json:"changed"`
+}
+
+type driftResource struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+
+	// Name of the resource in the export
+	Name string `json:"name,omitempty"`
+
+	// Address of the resource in the state
+	Address    string           `json:"address,omitempty"`
+	Attributes []attributeDrift `json:"attributes,omitempty"`
+}
+
+type attributeDrift struct {
+	Attribute  string      `json:"attribute"`
+	StateValue interface{} `json:"state_value"`
+	OrgValue   interface{} `json:"org_value"`
+}
+
+// stateFileResource is a managed resource read from a Terraform state file
+type stateFileResource struct {
+	Type       string
+	Name       string
+	Id         string
+	Attributes util.JsonMap
+}
+
+// tfStateFile covers both the v4 state written by Terraform and the v3 state written by the exporter
+type tfStateFile struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			Attributes util.JsonMap `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+	Modules []struct {
+		Resources map[string]struct {
+			Type    string                   `json:"type"`
+			Primary *terraform.InstanceState `json:"primary"`
+		} `json:"resources"`
+	} `json:"modules"`
+}
+
+// generateDriftReport compares the exported resources against drift_state_file and writes the report as Markdown
+// and JSON to the export directory
+func (g *GenesysCloudResourceExporter) generateDriftReport() diag.Diagnostics {
+	if g.driftStateFile == "" {
+		return nil
+	}
+
+	log.Printf("Comparing the export against the state file %s", g.driftStateFile)
+	stateResources, diagErr := g.readStateFileResources(g.driftStateFile)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	report := &driftReport{
+		StateFile: g.driftStateFile,
+		Unmanaged: make([]driftResource, 0),
+		Deleted:   make([]driftResource, 0),
+		Changed:   make([]driftResource, 0),
+	}
+
+	exportedIds := make(map[string]bool)
+	for _, resource := range g.resources {
+		if g.isDataSource(resource.Type, resource.Name) {
+			continue
+		}
+		key := resource.Type + "." + resource.State.ID
+		exportedIds[key] = true
+
+		stateResource, ok := stateResources[key]
+		if !ok {
+			report.Unmanaged = append(report.Unmanaged, driftResource{Type: resource.Type, Id: resource.State.ID, Name: resource.Name})
+			continue
+		}
+
+		orgConfig, diagErr := g.instanceStateToMap(resource.State, resource.CtyType)
+		if diagErr != nil {
+			return diagErr
+		}
+		g.sanitizeDriftConfigMap(resource.Type, resource.Name, orgConfig)
+		g.sanitizeDriftConfigMap(resource.Type, resource.Name, stateResource.Attributes)
+
+		if attributes := diffConfigMaps(stateResource.Attributes, orgConfig); len(attributes) > 0 {
+			report.Changed = append(report.Changed, driftResource{
+				Type:       resource.Type,
+				Id:         resource.State.ID,
+				Name:       resource.Name,
+				Address:    stateResource.Type + "." + stateResource.Name,
+				Attributes: attributes,
+			})
+		}
+	}
+
+	for key, stateResource := range stateResources {
+		if exportedIds[key] {
+			continue
+		}
+		// The resource may only have been left out of the export by a filter, in which case it was still listed
+		listedIds := (*g.exporters)[stateResource.Type].ListedResourceIds
+		if listedIds == nil || listedIds[stateResource.Id] {
+			log.Printf("Resource %s.%s is not part of the export, skipping drift detection", stateResource.Type, stateResource.Name)
+			continue
+		}
+		report.Deleted = append(report.Deleted, driftResource{
+			Type:    stateResource.Type,
+			Id:      stateResource.Id,
+			Address: stateResource.Type + "." + stateResource.Name,
+		})
+	}
+
+	report.sort()
+	return report.write(g.exportDirPath)
+}
+
+// readStateFileResources returns the managed resources of the exported types in a state file, keyed by type and ID
+func (g *GenesysCloudResourceExporter) readStateFileResources(path string) (map[string]*stateFileResource, diag.Diagnostics) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, diag.Errorf("Failed to read state file %s: %v", path, err)
+	}
+	var stateFile tfStateFile
+	if err := json.Unmarshal(contents, &stateFile); err != nil {
+		return nil, diag.Errorf("Failed to parse state file %s: %v", path, err)
+	}
+
+	resources := make(map[string]*stateFileResource)
+	add := func(resType string, name string, attributes util.JsonMap) {
+		if _, ok := (*g.exporters)[resType]; !ok {
+			return
+		}
+		id, _ := attributes["id"].(string)
+		if id == "" {
+			return
+		}
+		resources[resType+"."+id] = &stateFileResource{Type: resType, Name: name, Id: id, Attributes: attributes}
+	}
+
+	for _, resource := range stateFile.Resources {
+		if resource.Mode != "managed" {
+			continue
+		}
+		res := g.provider.ResourcesMap[resource.Type]
+		if res == nil {
+			continue
+		}
+		for _, instance := range resource.Instances {
+			add(resource.Type, resource.Name, normalizeStateAttributes(res, instance.Attributes))
+		}
+	}
+
+	for _, module := range stateFile.Modules {
+		for address, resource := range module.Resources {
+			res := g.provider.ResourcesMap[resource.Type]
+			if res == nil || resource.Primary == nil || strings.HasPrefix(address, "data.") {
+				continue
+			}
+			attributes, diagErr := g.instanceStateToMap(resource.Primary, res.CoreConfigSchema().ImpliedType())
+			if diagErr != nil {
+				return nil, diagErr
+			}
+			add(resource.Type, strings.TrimPrefix(address, resource.Type+"."), attributes)
+		}
+	}
+
+	return resources, nil
+}
+
+// normalizeStateAttributes converts the attributes of a state file to the shape produced by instanceStateToMap.
+// Attributes the current schema doesn't know are kept as they are.
+func normalizeStateAttributes(res *schema.Resource, attributes util.JsonMap) util.JsonMap {
+	stateVal, err := schema.JSONMapToStateValue(attributes, res.CoreConfigSchema())
+	if err != nil {
+		log.Printf("State attributes don't match the current schema, comparing them unchanged: %v", err)
+		return attributes
+	}
+	jsonMap, err := schema.StateValueToJSONMap(stateVal, res.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		return attributes
+	}
+	return jsonMap
+}
+
+// sanitizeDriftConfigMap sanitizes a config map the same way for both sides of the comparison. References are
+// kept as IDs when the referenced resource isn't exported, so that they can still be compared.
+func (g *GenesysCloudResourceExporter) sanitizeDriftConfigMap(resType string, name string, configMap util.JsonMap) {
+	g.sanitizeConfigMap(resType, name, configMap, "", *g.exporters, true, false, false)
+}
+
+// diffConfigMaps returns the attributes that differ between two sanitized config maps
+func diffConfigMaps(stateConfig util.JsonMap, orgConfig util.JsonMap) []attributeDrift {
+	stateValues := make(map[string]interface{})
+	orgValues := make(map[string]interface{})
+	flattenConfigValue("", map[string]interface{}(stateConfig), stateValues)
+	flattenConfigValue("", map[string]interface{}(orgConfig), orgValues)
+
+	attributes := make(map[string]bool)
+	for attribute := range stateValues {
+		attributes[attribute] = true
+	}
+	for attribute := range orgValues {
+		attributes[attribute] = true
+	}
+
+	drift := make([]attributeDrift, 0)
+	for attribute := range attributes {
+		if !reflect.DeepEqual(stateValues[attribute], orgValues[attribute]) {
+			drift = append(drift, attributeDrift{Attribute: attribute, StateValue: stateValues[attribute], OrgValue: orgValues[attribute]})
+		}
+	}
+	sort.Slice(drift, func(i, j int) bool { return drift[i].Attribute < drift[j].Attribute })
+	return drift
+}
+
+// flattenConfigValue flattens nested maps and lists into attribute paths such as 'members.0.user_id'. Nil values
+// are left out, which is how sanitizeConfigMap marks removed attributes.
+func flattenConfigValue(path string, value interface{}, values map[string]interface{}) {
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+
+	switch v := value.(type) {
+	case nil:
+	case map[string]interface{}:
+		for key, nested := range v {
+			flattenConfigValue(join(key), nested, values)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			flattenConfigValue(join(fmt.Sprint(i)), nested, values)
+		}
+	case []string:
+		for i, nested := range v {
+			flattenConfigValue(join(fmt.Sprint(i)), nested, values)
+		}
+	default:
+		values[path] = v
+	}
+}
+
+func (r *driftReport) sort() {
+	for _, resources := range [][]driftResource{r.Unmanaged, r.Deleted, r.Changed} {
+		sort.Slice(resources, func(i, j int) bool {
+			if resources[i].Type != resources[j].Type {
+				return resources[i].Type < resources[j].Type
+			}
+			return resources[i].Id < resources[j].Id
+		})
+	}
+}
+
+func (r *driftReport) write(dir string) diag.Diagnostics {
+	jsonData, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode drift report as JSON: %v", err)
+	}
+	if diagErr := files.WriteToFile(jsonData, filepath.Join(dir, defaultDriftReportJSONFile)); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Drift report: %d unmanaged, %d deleted and %d changed resources", len(r.Unmanaged), len(r.Deleted), len(r.Changed))
+	return files.WriteToFile([]byte(r.markdown()), filepath.Join(dir, defaultDriftReportMarkdownFile))
+}
+
+func (r *driftReport) markdown() string {
+	var sb strings.Builder
+	sb.WriteString("# Drift Report\n\n")
+	sb.WriteString(fmt.Sprintf("Compared against `%s`.\n", r.StateFile))
+
+	sb.WriteString(fmt.Sprintf("\n## Unmanaged resources (%d)\n\nResources that exist in the org but not in the state.\n", len(r.Unmanaged)))
+	if len(r.Unmanaged) > 0 {
+		sb.WriteString("\n| Type | ID | Name |\n| --- | --- | --- |\n")
+		for _, resource := range r.Unmanaged {
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s |\n", resource.Type, resource.Id, escapeMarkdownCell(resource.Name)))
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("\n## Deleted resources (%d)\n\nResources that are in the state but no longer exist in the org.\n", len(r.Deleted)))
+	if len(r.Deleted) > 0 {
+		sb.WriteString("\n| Address | ID |\n| --- | --- |\n")
+		for _, resource := range r.Deleted {
+			sb.WriteString(fmt.Sprintf("| %s | %s |\n", resource.Address, resource.Id))
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("\n## Changed resources (%d)\n\nResources whose attributes in the org differ from the state.\n", len(r.Changed)))
+	for _, resource := range r.Changed {
+		sb.WriteString(fmt.Sprintf("\n### %s (%s)\n\n| Attribute | State | Org |\n| --- | --- | --- |\n", resource.Address, resource.Id))
+		for _, attribute := range resource.Attributes {
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s |\n", attribute.Attribute, formatDriftValue(attribute.StateValue), formatDriftValue(attribute.OrgValue)))
+		}
+	}
+	return sb.String()
+}
+
+func formatDriftValue(value interface{}) string {
+	if value == nil {
+		return "_(not set)_"
+	}
+	return "`" + escapeMarkdownCell(fmt.Sprint(value)) + "`"
+}
+
+func escapeMarkdownCell(value string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(value)
+}
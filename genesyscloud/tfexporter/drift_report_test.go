@@ -0,0 +1,100 @@
+package tfexporter
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportDriftReport(t *testing.T) {
+	exportDir := t.TempDir()
+	api := &unitTestApi{
+		descriptions: map[string]string{"same": "a", "changed": "new", "unmanaged": "c", "filtered": "d"},
+		reads:        make(map[string]int),
+	}
+	exporter := &resourceExporter.ResourceExporter{SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+		"same":      {Name: "same"},
+		"changed":   {Name: "changed"},
+		"unmanaged": {Name: "unmanaged"},
+	}}
+	// "filtered" was listed but left out of the export by a filter
+	exporter.ListedResourceIds = map[string]bool{"same": true, "changed": true, "unmanaged": true, "filtered": true}
+	exporters := map[string]*resourceExporter.ResourceExporter{unitTestResourceType: exporter}
+
+	stateFile := filepath.Join(t.TempDir(), "terraform.tfstate")
+	stateResource := func(name string, description string) map[string]interface{} {
+		return map[string]interface{}{
+			"mode":      "managed",
+			"type":      unitTestResourceType,
+			"name":      name,
+			"instances": []interface{}{map[string]interface{}{"attributes": map[string]interface{}{"id": name, "description": description}}},
+		}
+	}
+	state, _ := json.Marshal(map[string]interface{}{
+		"version": 4,
+		"resources": []interface{}{
+			stateResource("same", "a"),
+			stateResource("changed", "old"),
+			stateResource("deleted", "e"),
+			stateResource("filtered", "d"),
+		},
+	})
+	assert.Nil(t, os.WriteFile(stateFile, state, 0644))
+
+	gre := &GenesysCloudResourceExporter{
+		provider:       api.provider(),
+		exporters:      &exporters,
+		exportDirPath:  exportDir,
+		driftStateFile: stateFile,
+		ctx:            context.Background(),
+	}
+	resources, diagErr := gre.getResourcesForType(unitTestResourceType, gre.provider, exporter, nil)
+	assert.Nil(t, diagErr)
+	gre.resources = resources
+	api.resetReads()
+
+	diagErr = gre.generateDriftReport()
+	assert.Nil(t, diagErr)
+	assert.Empty(t, api.reads, "resources missing from the export must be checked against the listed ids")
+
+	contents, err := os.ReadFile(filepath.Join(exportDir, defaultDriftReportJSONFile))
+	assert.Nil(t, err)
+	var report driftReport
+	assert.Nil(t, json.Unmarshal(contents, &report))
+
+	assert.Equal(t, []driftResource{{Type: unitTestResourceType, Id: "unmanaged", Name: "unmanaged"}}, report.Unmanaged)
+	assert.Equal(t, []driftResource{{Type: unitTestResourceType, Id: "deleted", Address: unitTestResourceType + ".deleted"}}, report.Deleted)
+	assert.Equal(t, []driftResource{{
+		Type:       unitTestResourceType,
+		Id:         "changed",
+		Name:       "changed",
+		Address:    unitTestResourceType + ".changed",
+		Attributes: []attributeDrift{{Attribute: "description", StateValue: "old", OrgValue: "new"}},
+	}}, report.Changed)
+
+	markdown, err := os.ReadFile(filepath.Join(exportDir, defaultDriftReportMarkdownFile))
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(string(markdown), "| description | `old` | `new` |"), string(markdown))
+}
+
+func TestUnitTfExportDiffConfigMapsIgnoresRemovedAttributes(t *testing.T) {
+	stateConfig := map[string]interface{}{
+		"name":    "queue",
+		"members": []interface{}{map[string]interface{}{"user_id": "a", "ring_num": 1.0}},
+		"skills":  nil,
+	}
+	orgConfig := map[string]interface{}{
+		"name":    "queue",
+		"members": []interface{}{map[string]interface{}{"user_id": "b", "ring_num": 1.0}},
+	}
+
+	drift := diffConfigMaps(stateConfig, orgConfig)
+	assert.Equal(t, []attributeDrift{{Attribute: "members.0.user_id", StateValue: "a", OrgValue: "b"}}, drift)
+}
@@ -231,14 +231,6 @@ func determineVarValue(s *schema.Schema) interface{} {
 	return nil
 }
 
-// Correct exported e164 number e.g. +(1) 111-222-333 --> +1111222333
-func sanitizeE164Number(number string) string {
-	charactersToRemove := []string{" ", "-", "(", ")"}
-	for _, c := range charactersToRemove {
-		number = strings.Replace(number, c, "", -1)
-	}
-	return number
-}
 func sanitizeRrule(input string) string {
 	attributeRegex := map[string]*regexp.Regexp{
 		"INTERVAL":   regexp.MustCompile(`INTERVAL=([1-9][0-9]*|0?[1-9][0-9]*);`),
@@ -0,0 +1,204 @@
+package tfexporter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+This file contains the manifest written next to incremental exports. The manifest records the id, version and state
+hash of every exported resource, the states themselves are kept in a separate cache file that only the owner can
+read. When the export is run again, resources whose version is unchanged are taken from the cache instead of being
+read from Genesys Cloud. Neither file is published by the output sinks.
+*/
+const (
+	exportManifestFile   = ".genesyscloud_export_manifest.json"
+	exportStateCacheFile = ".genesyscloud_export_state_cache.json"
+)
+
+type exportManifest struct {
+	// Provider version that wrote the manifest. States written by another version are never reused
+	// because the resource schemas may differ.
+	ProviderVersion string `json:"provider_version"`
+
+	// Resource type -> resource ID -> entry
+	Resources map[string]map[string]*manifestEntry `json:"resources"`
+
+	mu sync.Mutex
+}
+
+type manifestEntry struct {
+	Name       string `json:"name"`
+	IdPrefix   string `json:"id_prefix,omitempty"`
+	Version    string `json:"version,omitempty"`
+	DataSource bool   `json:"data_source,omitempty"`
+	Hash       string `json:"hash"`
+
+	// Stored in the state cache file
+	State *terraform.InstanceState `json:"-"`
+}
+
+// exportStateCache is the content of the state cache file: resource type -> resource ID -> state
+type exportStateCache map[string]map[string]*terraform.InstanceState
+
+func newExportManifest(providerVersion string) *exportManifest {
+	return &exportManifest{
+		ProviderVersion: providerVersion,
+		Resources:       make(map[string]map[string]*manifestEntry),
+	}
+}
+
+// loadExportManifest reads the manifest of a previous export from dir. A missing manifest is not an error,
+// an empty manifest is returned instead so that every resource is read.
+func loadExportManifest(dir string, providerVersion string) (*exportManifest, error) {
+	manifest := newExportManifest(providerVersion)
+
+	contents, err := os.ReadFile(filepath.Join(dir, exportManifestFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return manifest, nil
+		}
+		return manifest, err
+	}
+
+	var previous exportManifest
+	if err := json.Unmarshal(contents, &previous); err != nil {
+		return manifest, fmt.Errorf("failed to parse export manifest: %v", err)
+	}
+	if previous.ProviderVersion != providerVersion {
+		log.Printf("Export manifest was written by provider version %s, all resources will be read", previous.ProviderVersion)
+		return manifest, nil
+	}
+	if previous.Resources == nil {
+		return manifest, nil
+	}
+
+	contents, err = os.ReadFile(filepath.Join(dir, exportStateCacheFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return manifest, nil
+		}
+		return manifest, err
+	}
+	var states exportStateCache
+	if err := json.Unmarshal(contents, &states); err != nil {
+		return manifest, fmt.Errorf("failed to parse export state cache: %v", err)
+	}
+	for resType, entries := range previous.Resources {
+		for id, entry := range entries {
+			entry.State = states[resType][id]
+		}
+	}
+	manifest.Resources = previous.Resources
+	return manifest, nil
+}
+
+// add records the state of a resource that is part of the export
+func (m *exportManifest) add(resType string, id string, resMeta *resourceExporter.ResourceMeta, state *terraform.InstanceState, isDataSource bool) {
+	if m == nil {
+		return
+	}
+	hash, err := hashInstanceState(state)
+	if err != nil {
+		log.Printf("Failed to hash state of %s %s, it will not be added to the export manifest: %v", resType, id, err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.Resources[resType] == nil {
+		m.Resources[resType] = make(map[string]*manifestEntry)
+	}
+	m.Resources[resType][id] = &manifestEntry{
+		Name:       resMeta.Name,
+		IdPrefix:   resMeta.IdPrefix,
+		Version:    resMeta.Version,
+		DataSource: isDataSource,
+		Hash:       hash,
+		State:      state,
+	}
+}
+
+// unchangedState returns the recorded state of a resource if it can be reused, or nil if the resource must be read.
+// A state is only reused when the exporter reported a version for the resource and that version, the name and the
+// way the resource is exported are the same as in the previous export.
+func (m *exportManifest) unchangedState(resType string, id string, resMeta *resourceExporter.ResourceMeta, isDataSource bool) *terraform.InstanceState {
+	if m == nil || resMeta.Version == "" {
+		return nil
+	}
+
+	m.mu.Lock()
+	entry, ok := m.Resources[resType][id]
+	m.mu.Unlock()
+	if !ok || entry.State == nil {
+		return nil
+	}
+	if entry.Version != resMeta.Version || entry.Name != resMeta.Name || entry.IdPrefix != resMeta.IdPrefix || entry.DataSource != isDataSource {
+		return nil
+	}
+
+	// Guard against manifests that were edited by hand or only partially written
+	if hash, err := hashInstanceState(entry.State); err != nil || hash != entry.Hash {
+		log.Printf("Export manifest entry for %s %s does not match its hash, it will be read again", resType, id)
+		return nil
+	}
+	return entry.State
+}
+
+// write saves the manifest and the state cache to dir. Nothing is written for exports that aren't incremental.
+func (m *exportManifest) write(dir string) diag.Diagnostics {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode export manifest as JSON: %v", err)
+	}
+	path := filepath.Join(dir, exportManifestFile)
+	log.Printf("Writing export manifest to %s", path)
+	if diagErr := files.WriteToFile(data, path); diagErr != nil {
+		return diagErr
+	}
+
+	states := make(exportStateCache)
+	for resType, entries := range m.Resources {
+		states[resType] = make(map[string]*terraform.InstanceState, len(entries))
+		for id, entry := range entries {
+			states[resType][id] = entry.State
+		}
+	}
+	data, err = json.Marshal(states)
+	if err != nil {
+		return diag.Errorf("Failed to encode export state cache as JSON: %v", err)
+	}
+	// States can hold sensitive attributes
+	if err := os.WriteFile(filepath.Join(dir, exportStateCacheFile), data, 0600); err != nil {
+		return diag.Errorf("Failed to write export state cache: %v", err)
+	}
+	return nil
+}
+
+func hashInstanceState(state *terraform.InstanceState) (string, error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,167 @@
+package tfexporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const unitTestResourceType = "genesyscloud_unit_test_resource"
+
+// unitTestApi stands in for Genesys Cloud and counts how often each object is read
+type unitTestApi struct {
+	mu           sync.Mutex
+	descriptions map[string]string
+	reads        map[string]int
+}
+
+func (a *unitTestApi) provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			unitTestResourceType: {
+				Schema: map[string]*schema.Schema{
+					"description": {Type: schema.TypeString, Optional: true},
+				},
+				ReadContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+					a.mu.Lock()
+					defer a.mu.Unlock()
+					a.reads[d.Id()]++
+					description, ok := a.descriptions[d.Id()]
+					if !ok {
+						d.SetId("")
+						return nil
+					}
+					_ = d.Set("description", description)
+					return nil
+				},
+			},
+		},
+	}
+}
+
+func (a *unitTestApi) resetReads() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.reads = make(map[string]int)
+}
+
+func TestUnitTfExportIncrementalReadsOnlyChangedResources(t *testing.T) {
+	exportDir := t.TempDir()
+	api := &unitTestApi{
+		descriptions: map[string]string{"unchanged": "a", "modified": "b", "deleted": "c", "unversioned": "d"},
+		reads:        make(map[string]int),
+	}
+	provider := api.provider()
+
+	// First export, there is no manifest to reuse yet
+	gre := &GenesysCloudResourceExporter{version: "1.0.0", exportDirPath: exportDir, incremental: true}
+	gre.setupManifest()
+	exporter := &resourceExporter.ResourceExporter{SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+		"unchanged":   {Name: "unchanged", Version: "1"},
+		"modified":    {Name: "modified", Version: "1"},
+		"deleted":     {Name: "deleted", Version: "1"},
+		"unversioned": {Name: "unversioned"},
+	}}
+	fullResources, diagErr := gre.getResourcesForType(unitTestResourceType, provider, exporter, nil)
+	assert.Nil(t, diagErr)
+	assert.Equal(t, 4, len(fullResources))
+	assert.Nil(t, gre.manifest.write(exportDir))
+
+	// Incremental export after "modified" was updated, "deleted" was deleted and "added" was created
+	api.descriptions["modified"] = "b2"
+	delete(api.descriptions, "deleted")
+	api.descriptions["added"] = "e"
+	api.resetReads()
+
+	gre = &GenesysCloudResourceExporter{version: "1.0.0", exportDirPath: exportDir, incremental: true}
+	gre.setupManifest()
+	exporter = &resourceExporter.ResourceExporter{SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+		"unchanged":   {Name: "unchanged", Version: "1"},
+		"modified":    {Name: "modified", Version: "2"},
+		"unversioned": {Name: "unversioned"},
+		"added":       {Name: "added", Version: "1"},
+	}}
+	incrementalResources, diagErr := gre.getResourcesForType(unitTestResourceType, provider, exporter, nil)
+	assert.Nil(t, diagErr)
+	assert.Equal(t, map[string]int{"modified": 1, "unversioned": 1, "added": 1}, api.reads)
+
+	// The result matches a full export of the current objects
+	api.resetReads()
+	gre = &GenesysCloudResourceExporter{version: "1.0.0", exportDirPath: t.TempDir()}
+	gre.setupManifest()
+	exporter = &resourceExporter.ResourceExporter{SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+		"unchanged":   {Name: "unchanged", Version: "1"},
+		"modified":    {Name: "modified", Version: "2"},
+		"unversioned": {Name: "unversioned"},
+		"added":       {Name: "added", Version: "1"},
+	}}
+	expectedResources, diagErr := gre.getResourcesForType(unitTestResourceType, provider, exporter, nil)
+	assert.Nil(t, diagErr)
+	assert.Equal(t, statesById(expectedResources), statesById(incrementalResources))
+}
+
+func TestUnitTfExportManifestIgnoresStaleEntries(t *testing.T) {
+	exportDir := t.TempDir()
+	resMeta := &resourceExporter.ResourceMeta{Name: "queue", Version: "3"}
+	state := &terraform.InstanceState{ID: "queue-id", Attributes: map[string]string{"id": "queue-id", "name": "queue"}}
+
+	manifest := newExportManifest("1.0.0")
+	manifest.add(unitTestResourceType, "queue-id", resMeta, state, false)
+	assert.Nil(t, manifest.write(exportDir))
+
+	manifestContents, err := os.ReadFile(filepath.Join(exportDir, exportManifestFile))
+	assert.Nil(t, err)
+	assert.NotContains(t, string(manifestContents), "attributes", "states must only be stored in the state cache")
+	stateCacheInfo, err := os.Stat(filepath.Join(exportDir, exportStateCacheFile))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), stateCacheInfo.Mode().Perm())
+
+	loaded, err := loadExportManifest(exportDir, "1.0.0")
+	assert.Nil(t, err)
+	assert.Equal(t, state.Attributes, loaded.unchangedState(unitTestResourceType, "queue-id", resMeta, false).Attributes)
+	assert.Nil(t, loaded.unchangedState(unitTestResourceType, "queue-id", &resourceExporter.ResourceMeta{Name: "queue", Version: "4"}, false), "a new version must be read")
+	assert.Nil(t, loaded.unchangedState(unitTestResourceType, "queue-id", &resourceExporter.ResourceMeta{Name: "renamed", Version: "3"}, false), "a new name must be read")
+	assert.Nil(t, loaded.unchangedState(unitTestResourceType, "queue-id", resMeta, true), "a resource exported as a data source must be read")
+
+	loaded.Resources[unitTestResourceType]["queue-id"].State.Attributes["name"] = "edited"
+	assert.Nil(t, loaded.unchangedState(unitTestResourceType, "queue-id", resMeta, false), "an entry that doesn't match its hash must be read")
+
+	loaded, err = loadExportManifest(exportDir, "2.0.0")
+	assert.Nil(t, err)
+	assert.Nil(t, loaded.unchangedState(unitTestResourceType, "queue-id", resMeta, false), "a manifest of another provider version must not be used")
+
+	assert.Nil(t, os.WriteFile(filepath.Join(exportDir, exportManifestFile), []byte("{"), 0644))
+	loaded, err = loadExportManifest(exportDir, "1.0.0")
+	assert.NotNil(t, err)
+	assert.Nil(t, loaded.unchangedState(unitTestResourceType, "queue-id", resMeta, false))
+}
+
+func TestUnitTfExportManifestOnlyWrittenWhenIncremental(t *testing.T) {
+	exportDir := t.TempDir()
+	gre := &GenesysCloudResourceExporter{version: "1.0.0", exportDirPath: exportDir}
+	gre.setupManifest()
+	gre.manifest.add(unitTestResourceType, "queue-id", &resourceExporter.ResourceMeta{Name: "queue", Version: "1"}, &terraform.InstanceState{ID: "queue-id"}, false)
+	assert.Nil(t, gre.manifest.write(exportDir))
+
+	_, err := os.Stat(filepath.Join(exportDir, exportManifestFile))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(exportDir, exportStateCacheFile))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func statesById(resources []resourceExporter.ResourceInfo) map[string]*terraform.InstanceState {
+	states := make(map[string]*terraform.InstanceState)
+	for _, resource := range resources {
+		states[resource.State.ID] = resource.State
+	}
+	return states
+}
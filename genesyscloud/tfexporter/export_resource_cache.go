@@ -0,0 +1,75 @@
+package tfexporter
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+const defaultResourceCacheTtl = "1h"
+
+// getOrganizationId returns the ID of the org the export reads from, so that the cached objects of different orgs are
+// kept apart
+var getOrganizationId = func(clientConfig *platformclientv2.Configuration) (string, *platformclientv2.APIResponse, error) {
+	org, resp, err := platformclientv2.NewOrganizationApiWithConfig(clientConfig).GetOrganizationsMe()
+	if err != nil {
+		return "", resp, err
+	}
+	return *org.Id, resp, nil
+}
+
+func validateDuration(val interface{}, key string) ([]string, []error) {
+	if _, err := time.ParseDuration(val.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a duration such as '30m' or '12h': %v", key, err)}
+	}
+	return nil, nil
+}
+
+// setupResourceCache makes the caches of the proxies created during the export store the objects they read in files,
+// so that the next exports reuse them instead of reading them again until they expire
+func (g *GenesysCloudResourceExporter) setupResourceCache() diag.Diagnostics {
+	cacheConfig := g.d.Get("resource_cache").([]interface{})
+	if len(cacheConfig) == 0 || cacheConfig[0] == nil {
+		return nil
+	}
+	settings := cacheConfig[0].(map[string]interface{})
+
+	ttl, err := time.ParseDuration(settings["ttl"].(string))
+	if err != nil {
+		return diag.Errorf("Invalid resource cache ttl %s: %v", settings["ttl"], err)
+	}
+	clientConfig := g.meta.(*provider.ProviderMeta).ClientConfig
+	orgId, resp, err := getOrganizationId(clientConfig)
+	if err != nil {
+		return diag.Errorf("Failed to get the org of the resource cache: %v %v", err, resp)
+	}
+
+	cacheDir := filepath.Join(settings["directory"].(string), orgId)
+	if settings["invalidate"].(bool) {
+		log.Printf("Deleting the cached objects in %s", cacheDir)
+		if err := rc.ClearPersistentCache(cacheDir); err != nil {
+			return diag.Errorf("Failed to delete the resource cache in %s: %v", cacheDir, err)
+		}
+	}
+
+	log.Printf("Caching the exported objects in %s for %s", cacheDir, ttl)
+	rc.ConfigurePersistentCache(clientConfig, cacheDir, ttl)
+	return nil
+}
+
+// closeResourceCache writes the cached objects to their files and keeps the caches created after the export in memory
+func (g *GenesysCloudResourceExporter) closeResourceCache() {
+	if len(g.d.Get("resource_cache").([]interface{})) == 0 {
+		return
+	}
+	if err := rc.FlushPersistentCaches(); err != nil {
+		log.Printf("Failed to write the resource cache: %v", err)
+	}
+	rc.ConfigurePersistentCache(g.meta.(*provider.ProviderMeta).ClientConfig, "", 0)
+}
@@ -0,0 +1,499 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+/*
+This file contains the validation of an export. When validate_config is set, the config files written to the export
+directory are parsed again, and every resource and data source block is validated against the schema of its type the
+same way Terraform does during a plan: required attributes, attribute types, ValidateFuncs and unknown attributes.
+Values that are only known during a plan, such as references, are not validated. References to resources, data sources,
+variables, locals and modules that are not declared in the same directory are reported as well.
+*/
+const defaultValidationReportFile = "validation_report.json"
+
+// unknownConfigValue marks values that are only known during a plan. It is the UnknownVariableValue of the plugin SDK,
+// which is in an internal package.
+const unknownConfigValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// Arguments that Terraform handles for every resource, they are not part of the resource schemas
+var configMetaArguments = []string{"count", "depends_on", "for_each", "lifecycle", "provider", "provisioner", "connection"}
+
+// Functions the exporter writes to the config that can be evaluated without a plan
+var configEvalContext = &hcl.EvalContext{
+	Functions: map[string]function.Function{
+		"jsonencode": stdlib.JSONEncodeFunc,
+	},
+}
+
+type validationReport struct {
+	ProblemCount int                 `json:"problem_count"`
+	Problems     []validationProblem `json:"problems"`
+}
+
+type validationProblem struct {
+	// File of the problem, relative to the export directory
+	File      string `json:"file"`
+	Address   string `json:"address,omitempty"`
+	Attribute string `json:"attribute,omitempty"`
+	Message   string `json:"message"`
+}
+
+// configModule holds the blocks of the config files of a directory, which form a Terraform module
+type configModule struct {
+	dir       string
+	blocks    []*configBlock
+	declared  map[string]bool
+	problems  []validationProblem
+	exportDir string
+}
+
+// configBlock is a resource or data source block of the config
+type configBlock struct {
+	file       string
+	dataSource bool
+	resType    string
+	name       string
+	raw        map[string]interface{}
+	references []configReference
+}
+
+type configReference struct {
+	attribute string
+	traversal hcl.Traversal
+}
+
+func (b *configBlock) address() string {
+	if b.dataSource {
+		return "data." + b.resType + "." + b.name
+	}
+	return b.resType + "." + b.name
+}
+
+// validateExportedConfig validates the config files in the export directory and writes the problems to the validation
+// report. The problems are returned as a warning.
+func (g *GenesysCloudResourceExporter) validateExportedConfig() diag.Diagnostics {
+	if !g.d.Get("validate_config").(bool) {
+		return nil
+	}
+
+	log.Printf("Validating the config exported to %s", g.exportDirPath)
+	modules, err := loadConfigModules(g.exportDirPath)
+	if err != nil {
+		return diag.Errorf("Failed to read the exported config: %v", err)
+	}
+
+	report := &validationReport{Problems: make([]validationProblem, 0)}
+	for _, module := range modules {
+		report.Problems = append(report.Problems, module.validate(g.provider)...)
+	}
+	report.sort()
+	report.ProblemCount = len(report.Problems)
+
+	jsonData, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode validation report as JSON: %v", err)
+	}
+	if diagErr := files.WriteToFile(jsonData, filepath.Join(g.exportDirPath, defaultValidationReportFile)); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Validation report: %d problems", report.ProblemCount)
+	if report.ProblemCount == 0 {
+		return nil
+	}
+	return diag.Diagnostics{report.warning()}
+}
+
+// warning summarizes the problems of the report, listing the first ones
+func (r *validationReport) warning() diag.Diagnostic {
+	const maxListedProblems = 10
+
+	var details []string
+	for i, problem := range r.Problems {
+		if i == maxListedProblems {
+			details = append(details, fmt.Sprintf("and %d more", len(r.Problems)-maxListedProblems))
+			break
+		}
+		details = append(details, problem.String())
+	}
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("The exported config has %d problems, see %s", len(r.Problems), defaultValidationReportFile),
+		Detail:   strings.Join(details, "\n"),
+	}
+}
+
+func (p validationProblem) String() string {
+	location := p.File
+	if p.Address != "" {
+		location += " " + p.Address
+	}
+	if p.Attribute != "" {
+		location += "." + p.Attribute
+	}
+	return location + ": " + p.Message
+}
+
+func (r *validationReport) sort() {
+	sort.SliceStable(r.Problems, func(i, j int) bool {
+		if r.Problems[i].File != r.Problems[j].File {
+			return r.Problems[i].File < r.Problems[j].File
+		}
+		if r.Problems[i].Address != r.Problems[j].Address {
+			return r.Problems[i].Address < r.Problems[j].Address
+		}
+		return r.Problems[i].Attribute < r.Problems[j].Attribute
+	})
+}
+
+// loadConfigModules parses the config files under dir, grouped by directory
+func loadConfigModules(dir string) ([]*configModule, error) {
+	modules := make(map[string]*configModule)
+	err := filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path != dir && strings.HasPrefix(entry.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		isJson := strings.HasSuffix(path, "."+resourceJSONFileExt)
+		if !isJson && !strings.HasSuffix(path, ".tf") {
+			return nil
+		}
+
+		module, ok := modules[filepath.Dir(path)]
+		if !ok {
+			module = &configModule{dir: filepath.Dir(path), declared: make(map[string]bool), exportDir: dir}
+			modules[module.dir] = module
+		}
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if isJson {
+			module.parseJsonFile(path, contents)
+		} else {
+			module.parseHclFile(path, contents)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := make([]*configModule, 0, len(modules))
+	for _, module := range modules {
+		sorted = append(sorted, module)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].dir < sorted[j].dir
+	})
+	return sorted, nil
+}
+
+func (m *configModule) relativePath(path string) string {
+	if relPath, err := filepath.Rel(m.exportDir, path); err == nil {
+		return filepath.ToSlash(relPath)
+	}
+	return path
+}
+
+func (m *configModule) addProblem(path string, address string, attribute string, message string) {
+	m.problems = append(m.problems, validationProblem{File: m.relativePath(path), Address: address, Attribute: attribute, Message: message})
+}
+
+// parseHclFile reads the blocks of a .tf file
+func (m *configModule) parseHclFile(path string, contents []byte) {
+	file, diags := hclsyntax.ParseConfig(contents, path, hcl.InitialPos)
+	if diags.HasErrors() {
+		m.addProblem(path, "", "", diags.Error())
+		return
+	}
+
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		switch block.Type {
+		case "resource", "data":
+			if len(block.Labels) != 2 {
+				m.addProblem(path, "", "", fmt.Sprintf("%s block must have a type and a name", block.Type))
+				continue
+			}
+			configBlock := &configBlock{file: path, dataSource: block.Type == "data", resType: block.Labels[0], name: block.Labels[1]}
+			configBlock.raw = hclBodyToRaw(block.Body, "", &configBlock.references)
+			m.addBlock(configBlock)
+		case "variable":
+			if len(block.Labels) == 1 {
+				m.declared["var."+block.Labels[0]] = true
+			}
+		case "module":
+			if len(block.Labels) == 1 {
+				m.declared["module."+block.Labels[0]] = true
+			}
+		case "locals":
+			for name := range block.Body.Attributes {
+				m.declared["local."+name] = true
+			}
+		}
+	}
+}
+
+// hclBodyToRaw converts a block body to the raw config of the plugin SDK. Nested blocks are lists of maps.
+func hclBodyToRaw(body *hclsyntax.Body, attribute string, references *[]configReference) map[string]interface{} {
+	raw := make(map[string]interface{})
+	for name, attr := range body.Attributes {
+		if value, known := evaluateConfigExpression(attr.Expr, joinGraphAttribute(attribute, name), references); known {
+			if value != nil {
+				raw[name] = value
+			}
+		} else {
+			raw[name] = unknownConfigValue
+		}
+	}
+	for _, block := range body.Blocks {
+		nested, _ := raw[block.Type].([]interface{})
+		raw[block.Type] = append(nested, hclBodyToRaw(block.Body, joinGraphAttribute(attribute, block.Type), references))
+	}
+	return raw
+}
+
+// evaluateConfigExpression returns the value of an expression, or false if it is only known during a plan
+func evaluateConfigExpression(expr hclsyntax.Expression, attribute string, references *[]configReference) (interface{}, bool) {
+	variables := expr.Variables()
+	for _, traversal := range variables {
+		*references = append(*references, configReference{attribute: attribute, traversal: traversal})
+	}
+	if len(variables) > 0 {
+		return nil, false
+	}
+
+	value, diags := expr.Value(configEvalContext)
+	if diags.HasErrors() || !value.IsWhollyKnown() {
+		// e.g. functions such as filesha256 that depend on the directory of the plan
+		return nil, false
+	}
+	if value.IsNull() {
+		return nil, true
+	}
+	jsonValue, err := ctyjson.Marshal(value, value.Type())
+	if err != nil {
+		return nil, false
+	}
+	var raw interface{}
+	if err := json.Unmarshal(jsonValue, &raw); err != nil {
+		return nil, false
+	}
+	return raw, true
+}
+
+// parseJsonFile reads the blocks of a .tf.json file
+func (m *configModule) parseJsonFile(path string, contents []byte) {
+	var root map[string]interface{}
+	if err := json.Unmarshal(contents, &root); err != nil {
+		m.addProblem(path, "", "", fmt.Sprintf("invalid JSON: %v", err))
+		return
+	}
+
+	for _, blockType := range []string{"resource", "data"} {
+		types, _ := root[blockType].(map[string]interface{})
+		for resType, resources := range types {
+			resourcesMap, _ := resources.(map[string]interface{})
+			for name, body := range resourcesMap {
+				bodyMap, ok := body.(map[string]interface{})
+				if !ok {
+					m.addProblem(path, resType+"."+name, "", "the block must be a JSON object")
+					continue
+				}
+				configBlock := &configBlock{file: path, dataSource: blockType == "data", resType: resType, name: name}
+				configBlock.raw = jsonValueToRaw(bodyMap, "", &configBlock.references).(map[string]interface{})
+				m.addBlock(configBlock)
+			}
+		}
+	}
+	variables, _ := root["variable"].(map[string]interface{})
+	for name := range variables {
+		m.declared["var."+name] = true
+	}
+	modules, _ := root["module"].(map[string]interface{})
+	for name := range modules {
+		m.declared["module."+name] = true
+	}
+	locals, _ := root["locals"].(map[string]interface{})
+	for name := range locals {
+		m.declared["local."+name] = true
+	}
+}
+
+// jsonValueToRaw converts a value of a .tf.json file to the raw config of the plugin SDK. Strings are templates that
+// may contain references.
+func jsonValueToRaw(value interface{}, attribute string, references *[]configReference) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		raw := make(map[string]interface{})
+		for key, val := range v {
+			if attribute == "" && key == "depends_on" {
+				addJsonDependsOn(val, references)
+				continue
+			}
+			raw[key] = jsonValueToRaw(val, joinGraphAttribute(attribute, key), references)
+		}
+		return raw
+	case []interface{}:
+		raw := make([]interface{}, len(v))
+		for i, val := range v {
+			raw[i] = jsonValueToRaw(val, attribute, references)
+		}
+		return raw
+	case string:
+		expr, diags := hclsyntax.ParseTemplate([]byte(v), attribute, hcl.InitialPos)
+		if diags.HasErrors() {
+			return v
+		}
+		if evaluated, known := evaluateConfigExpression(expr, attribute, references); known {
+			return evaluated
+		}
+		return unknownConfigValue
+	}
+	return value
+}
+
+// addJsonDependsOn adds the references of a depends_on argument, which are written without interpolation in JSON
+func addJsonDependsOn(value interface{}, references *[]configReference) {
+	dependsOn, _ := value.([]interface{})
+	for _, dependency := range dependsOn {
+		dependencyStr, _ := dependency.(string)
+		if traversal, diags := hclsyntax.ParseTraversalAbs([]byte(dependencyStr), "depends_on", hcl.InitialPos); !diags.HasErrors() {
+			*references = append(*references, configReference{attribute: "depends_on", traversal: traversal})
+		}
+	}
+}
+
+func (m *configModule) addBlock(block *configBlock) {
+	m.declared[block.address()] = true
+	m.blocks = append(m.blocks, block)
+}
+
+// validate returns the problems of the blocks of the module
+func (m *configModule) validate(provider *schema.Provider) []validationProblem {
+	problems := append([]validationProblem(nil), m.problems...)
+	for _, block := range m.blocks {
+		for _, reference := range block.references {
+			if address, ok := referencedAddress(reference.traversal); ok && !m.declared[address] {
+				problems = append(problems, validationProblem{
+					File:      m.relativePath(block.file),
+					Address:   block.address(),
+					Attribute: reference.attribute,
+					Message:   fmt.Sprintf("reference to undeclared %s", address),
+				})
+			}
+		}
+
+		resource := provider.ResourcesMap[block.resType]
+		if block.dataSource {
+			resource = provider.DataSourcesMap[block.resType]
+		}
+		if resource == nil {
+			problems = append(problems, validationProblem{File: m.relativePath(block.file), Address: block.address(), Message: "unknown type " + block.resType})
+			continue
+		}
+
+		raw := make(map[string]interface{})
+		for key, value := range block.raw {
+			raw[key] = value
+		}
+		for _, metaArgument := range configMetaArguments {
+			delete(raw, metaArgument)
+		}
+		for _, diagnostic := range resource.Validate(terraform.NewResourceConfigRaw(raw)) {
+			if diagnostic.Severity != diag.Error {
+				continue
+			}
+			message := diagnostic.Summary
+			if diagnostic.Detail != "" {
+				message += ": " + diagnostic.Detail
+			}
+			problems = append(problems, validationProblem{
+				File:      m.relativePath(block.file),
+				Address:   block.address(),
+				Attribute: formatValidationPath(diagnostic.AttributePath),
+				Message:   message,
+			})
+		}
+	}
+	return problems
+}
+
+// referencedAddress returns the address of the object declared in the config that a reference points to, e.g.
+// genesyscloud_routing_queue.support for genesyscloud_routing_queue.support.id
+func referencedAddress(traversal hcl.Traversal) (string, bool) {
+	var names []string
+	for _, step := range traversal {
+		switch s := step.(type) {
+		case hcl.TraverseRoot:
+			names = append(names, s.Name)
+		case hcl.TraverseAttr:
+			names = append(names, s.Name)
+		default:
+			return "", false
+		}
+		if len(names) == 3 {
+			break
+		}
+	}
+	if len(names) < 2 {
+		return "", false
+	}
+
+	switch names[0] {
+	case "path", "terraform", "each", "count", "self":
+		return "", false
+	case "data":
+		if len(names) < 3 {
+			return "", false
+		}
+		return strings.Join(names[:3], "."), true
+	}
+	return strings.Join(names[:2], "."), true
+}
+
+// formatValidationPath formats the path of an attribute in a diagnostic as in the state, e.g. routing_rules.0.operator
+func formatValidationPath(path cty.Path) string {
+	var parts []string
+	for _, step := range path {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			parts = append(parts, s.Name)
+		case cty.IndexStep:
+			switch s.Key.Type() {
+			case cty.Number:
+				index, _ := s.Key.AsBigFloat().Int64()
+				parts = append(parts, strconv.FormatInt(index, 10))
+			case cty.String:
+				parts = append(parts, s.Key.AsString())
+			}
+		}
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,184 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func testValidationProvider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"genesyscloud_routing_queue": {
+				Schema: map[string]*schema.Schema{
+					"name":                    {Type: schema.TypeString, Required: true},
+					"queue_flow_id":           {Type: schema.TypeString, Optional: true},
+					"skill_evaluation_method": {Type: schema.TypeString, Optional: true, ValidateFunc: validation.StringInSlice([]string{"NONE", "BEST", "ALL"}, false)},
+					"members": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"user_id":     {Type: schema.TypeString, Required: true},
+								"ring_number": {Type: schema.TypeInt, Optional: true},
+							},
+						},
+					},
+				},
+			},
+			"genesyscloud_flow": {
+				Schema: map[string]*schema.Schema{
+					"filepath":          {Type: schema.TypeString, Required: true},
+					"file_content_hash": {Type: schema.TypeString, Optional: true},
+					"substitutions":     {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				},
+			},
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"genesyscloud_user": {
+				Schema: map[string]*schema.Schema{
+					"email": {Type: schema.TypeString, Optional: true},
+				},
+			},
+		},
+	}
+}
+
+func writeValidationTestFile(t *testing.T, path string, contents string) {
+	assert.Nil(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	assert.Nil(t, os.WriteFile(path, []byte(contents), os.ModePerm))
+}
+
+func TestUnitTfExportValidateExportedConfig(t *testing.T) {
+	exportDir := t.TempDir()
+	writeValidationTestFile(t, filepath.Join(exportDir, "genesyscloud.tf"), `
+resource "genesyscloud_routing_queue" "support" {
+  name                    = "Support"
+  queue_flow_id           = genesyscloud_flow.main.id
+  skill_evaluation_method = "BEST"
+  members {
+    user_id     = "${data.genesyscloud_user.agent.id}"
+    ring_number = 1
+  }
+  depends_on = [genesyscloud_flow.main]
+}
+
+resource "genesyscloud_routing_queue" "broken" {
+  skill_evaluation_method = "SOME"
+  members {
+    user_id = genesyscloud_user.missing.id
+  }
+  unknown = "value"
+}
+
+resource "genesyscloud_flow" "main" {
+  filepath          = var.flow_path
+  file_content_hash = "${filesha256(var.flow_path)}"
+  substitutions = {
+    config = jsonencode({ "a" = 1 })
+  }
+}
+
+data "genesyscloud_user" "agent" {
+  email = "agent@example.com"
+}
+
+variable "flow_path" {
+  type = string
+}
+`)
+	// Modules are validated on their own
+	writeValidationTestFile(t, filepath.Join(exportDir, "modules", "queues", "genesyscloud.tf.json"), `{
+  "resource": {
+    "genesyscloud_routing_queue": {
+      "sales": {
+        "name": "Sales",
+        "queue_flow_id": "${genesyscloud_flow.main.id}",
+        "skill_evaluation_method": "$${NONE}",
+        "depends_on": ["genesyscloud_flow.other"]
+      }
+    }
+  }
+}`)
+
+	gre := &GenesysCloudResourceExporter{
+		d:             schema.TestResourceDataRaw(t, ResourceTfExport().Schema, map[string]interface{}{"validate_config": true}),
+		exportDirPath: exportDir,
+		provider:      testValidationProvider(),
+	}
+	diags := gre.validateExportedConfig()
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+
+	reportData, err := os.ReadFile(filepath.Join(exportDir, defaultValidationReportFile))
+	assert.Nil(t, err)
+	var report validationReport
+	assert.Nil(t, json.Unmarshal(reportData, &report))
+
+	problems := make(map[string]bool)
+	for _, problem := range report.Problems {
+		problems[problem.File+" "+problem.Address+" "+problem.Attribute] = true
+	}
+	assert.Equal(t, map[string]bool{
+		"genesyscloud.tf genesyscloud_routing_queue.broken name":                                       true,
+		"genesyscloud.tf genesyscloud_routing_queue.broken unknown":                                    true,
+		"genesyscloud.tf genesyscloud_routing_queue.broken members.user_id":                            true,
+		"genesyscloud.tf genesyscloud_routing_queue.broken skill_evaluation_method":                    true,
+		"modules/queues/genesyscloud.tf.json genesyscloud_routing_queue.sales queue_flow_id":           true,
+		"modules/queues/genesyscloud.tf.json genesyscloud_routing_queue.sales depends_on":              true,
+		"modules/queues/genesyscloud.tf.json genesyscloud_routing_queue.sales skill_evaluation_method": true,
+	}, problems)
+	assert.Equal(t, len(report.Problems), report.ProblemCount)
+}
+
+func TestUnitTfExportValidationReportWrittenWithOutputFiles(t *testing.T) {
+	exportDir := t.TempDir()
+	gre := &GenesysCloudResourceExporter{
+		d:             schema.TestResourceDataRaw(t, ResourceTfExport().Schema, map[string]interface{}{"validate_config": true}),
+		version:       "1.0.0",
+		exportDirPath: exportDir,
+		provider:      testValidationProvider(),
+		resourceTypesMaps: map[string]resourceJSONMaps{
+			"genesyscloud_routing_queue": {"broken": util.JsonMap{"skill_evaluation_method": "NONE"}},
+		},
+	}
+	gre.setupManifest()
+
+	// The report is part of the output files, so it is included in the zip and in the published export
+	diags := gre.generateOutputFiles()
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	_, err := os.Stat(filepath.Join(exportDir, defaultValidationReportFile))
+	assert.Nil(t, err)
+}
+
+func TestUnitTfExportReferencedAddress(t *testing.T) {
+	tests := map[string]string{
+		"genesyscloud_flow.main.id":       "genesyscloud_flow.main",
+		"data.genesyscloud_user.agent.id": "data.genesyscloud_user.agent",
+		"var.flow_path":                   "var.flow_path",
+		"module.queues.queue_ids":         "module.queues",
+		"local.names":                     "local.names",
+		"path.module":                     "",
+		"each.value":                      "",
+	}
+	for expression, expected := range tests {
+		traversal, diags := hclsyntax.ParseTraversalAbs([]byte(expression), "", hcl.InitialPos)
+		assert.False(t, diags.HasErrors(), expression)
+		address, ok := referencedAddress(traversal)
+		assert.Equal(t, expected != "", ok, expression)
+		assert.Equal(t, expected, address, expression)
+	}
+}
@@ -93,6 +93,16 @@ type GenesysCloudResourceExporter struct {
 	cyclicDependsList      []string
 	ignoreCyclicDeps       bool
 	flowResourcesList      []string
+	incremental            bool
+	driftStateFile         string
+	includeImportBlocks    bool
+	resourceImports        map[string][]resourceImport
+	modularizeBy           string
+	moduleEnvironments     []string
+	outputSink             outputSink
+	previousManifest       *exportManifest
+	manifest               *exportManifest
+	resourceNaming         *resourceNaming
 }
 
 func configureExporterType(ctx context.Context, d *schema.ResourceData, gre *GenesysCloudResourceExporter, filterType ExporterFilterType) {
@@ -143,7 +153,13 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 		addDependsOn:         computeDependsOn(d),
 		filterType:           filterType,
 		includeStateFile:     d.Get("include_state_file").(bool),
+		includeImportBlocks:  d.Get("include_import_blocks").(bool),
+		modularizeBy:         d.Get("modularize_by").(string),
+		moduleEnvironments:   lists.InterfaceListToStrings(d.Get("module_environments").([]interface{})),
 		ignoreCyclicDeps:     d.Get("ignore_cyclic_deps").(bool),
+		incremental:          d.Get("incremental").(bool),
+		driftStateFile:       d.Get("drift_state_file").(string),
+		outputSink:           newOutputSink(d),
 		version:              meta.(*provider.ProviderMeta).Version,
 		provider:             provider.New(meta.(*provider.ProviderMeta).Version, providerResources, providerDataSources)(),
 		d:                    d,
@@ -157,6 +173,7 @@ func NewGenesysCloudResourceExporter(ctx context.Context, d *schema.ResourceData
 	}
 
 	gre.setupDataSource()
+	gre.setupManifest()
 
 	//Setting up the filter
 	configureExporterType(ctx, d, gre, filterType)
@@ -177,6 +194,19 @@ func computeDependsOn(d *schema.ResourceData) bool {
 }
 
 func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
+	// Step #0 Reuse the objects cached by previous exports
+	diagErr = g.setupResourceCache()
+	if diagErr != nil {
+		return diagErr
+	}
+	defer g.closeResourceCache()
+
+	// Load the names pinned by previous exports
+	diagErr = g.setupResourceNaming()
+	if diagErr != nil {
+		return diagErr
+	}
+
 	// Step #1 Retrieve the exporters we are have registered and have been requested by the user
 	diagErr = g.retrieveExporters()
 	if diagErr != nil {
@@ -212,16 +242,22 @@ func (g *GenesysCloudResourceExporter) Export() (diagErr diag.Diagnostics) {
 		return diagErr
 	}
 
-	// Step #7 Write the terraform state file along with either the HCL or JSON
-	diagErr = g.generateOutputFiles()
+	// Step #7 Write the terraform state file along with either the HCL or JSON. Validation problems are returned as a warning.
+	outputDiags := g.generateOutputFiles()
+	if outputDiags.HasError() {
+		return outputDiags
+	}
+
+	// Step #8 Publish the export directory to the configured output
+	diagErr = g.outputSink.publish(g.ctx, g.exportDirPath)
 	if diagErr != nil {
 		return diagErr
 	}
 
-	// step #8 Verify the terraform state file with Exporter Resources
+	// step #9 Verify the terraform state file with Exporter Resources
 	g.verifyTerraformState()
 
-	return nil
+	return outputDiags
 }
 
 func (g *GenesysCloudResourceExporter) setUpExportDirPath() (diagErr diag.Diagnostics) {
@@ -235,6 +271,20 @@ func (g *GenesysCloudResourceExporter) setUpExportDirPath() (diagErr diag.Diagno
 	return nil
 }
 
+// setupManifest loads the manifest of the previous export when the export is incremental
+func (g *GenesysCloudResourceExporter) setupManifest() {
+	if !g.incremental {
+		return
+	}
+	g.manifest = newExportManifest(g.version)
+
+	previousManifest, err := loadExportManifest(g.exportDirPath, g.version)
+	if err != nil {
+		log.Printf("Failed to load the export manifest, all resources will be read: %v", err)
+	}
+	g.previousManifest = previousManifest
+}
+
 func (g *GenesysCloudResourceExporter) setupDataSource() {
 	if replaceWithDatasource, ok := g.d.GetOk("replace_with_datasource"); ok {
 		dataSourceList := lists.InterfaceListToStrings(replaceWithDatasource.([]interface{}))
@@ -256,6 +306,12 @@ func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnos
 		exports = g.resourceTypeFilter(exports, *g.filterList)
 	}
 
+	var includedTypes []string
+	if (g.filterType == LegacyInclude || g.filterType == IncludeResources) && g.filterList != nil {
+		includedTypes = formatFilter(*g.filterList)
+	}
+	removeReplacedExporters(exports, includedTypes)
+
 	g.exporters = &exports
 
 	// Assign excluded attributes to the config Map
@@ -267,6 +323,23 @@ func (g *GenesysCloudResourceExporter) retrieveExporters() (diagErr diag.Diagnos
 	return nil
 }
 
+// removeReplacedExporters drops the exporters with ReplacesResourceTypes unless they are explicitly included. When they
+// are, the exporters of the resource types they replace are dropped instead.
+func removeReplacedExporters(exports map[string]*resourceExporter.ResourceExporter, includedTypes []string) {
+	for resType, exporter := range exports {
+		if len(exporter.ReplacesResourceTypes) == 0 {
+			continue
+		}
+		if !lists.ItemInSlice(resType, includedTypes) {
+			delete(exports, resType)
+			continue
+		}
+		for _, replacedType := range exporter.ReplacesResourceTypes {
+			delete(exports, replacedType)
+		}
+	}
+}
+
 // Removes the ::resource_name from the resource_types list
 func formatFilter(filter []string) []string {
 	newFilter := make([]string, 0)
@@ -368,6 +441,9 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 	g.dataSourceTypesMaps = make(map[string]resourceJSONMaps)
 	g.resourceTypesHCLBlocks = make(map[string]resourceHCLBlock, 0)
 	g.unresolvedAttrs = make([]unresolvableAttributeInfo, 0)
+	if g.includeImportBlocks {
+		g.resourceImports = make(map[string][]resourceImport)
+	}
 
 	for _, resource := range g.resources {
 		jsonResult, diagErr := g.instanceStateToMap(resource.State, resource.CtyType)
@@ -389,10 +465,15 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 
 		if !isDataSource {
 			// Removes zero values and sets proper reference expressions
-			unresolved, _ := g.sanitizeConfigMap(resource.Type, resource.Name, jsonResult, "", *g.exporters, g.includeStateFile, g.exportAsHCL, true)
+			// Resources that are imported keep references to resources outside the export, the same as with a state file
+			unresolved, _ := g.sanitizeConfigMap(resource.Type, resource.Name, jsonResult, "", *g.exporters, g.includeStateFile || g.includeImportBlocks, g.exportAsHCL, true)
 			if len(unresolved) > 0 {
 				g.unresolvedAttrs = append(g.unresolvedAttrs, unresolved...)
 			}
+
+			if g.includeImportBlocks {
+				g.resourceImports[resource.Type] = append(g.resourceImports[resource.Type], newResourceImport(resource))
+			}
 		} else {
 			g.sanitizeDataConfigMap(jsonResult)
 		}
@@ -403,6 +484,8 @@ func (g *GenesysCloudResourceExporter) buildResourceConfigMap() diag.Diagnostics
 			exportDir, _ := getFilePath(g.d, "")
 			if err := resourceFilesWriterFunc(resource.State.ID, exportDir, exporters[resource.Type].CustomFileWriter.SubDirectory, jsonResult, g.meta); err != nil {
 				log.Printf("An error has occurred while trying invoking the RetrieveAndWriteFilesFunc for resource type %s: %v", resource.Type, err)
+			} else {
+				g.removeResolvedAttrs(resource.Type, resource.Name, jsonResult)
 			}
 		}
 
@@ -463,12 +546,20 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 		}
 	}
 
-	var err diag.Diagnostics
-	if g.exportAsHCL {
-		hclExporter := NewHClExporter(g.resourceTypesHCLBlocks, g.unresolvedAttrs, providerSource, g.version, g.exportDirPath, g.splitFilesByResource)
+	// The module exporter rewrites the references of the config maps, so the graph is built from them first
+	err := g.writeDependencyGraph()
+	if err != nil {
+		return err
+	}
+
+	if g.modularizeBy != "" {
+		moduleExporter := NewModuleExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.resourceImports, *g.exporters, providerSource, g.version, g.exportDirPath, g.modularizeBy, g.moduleEnvironments)
+		err = moduleExporter.exportModules()
+	} else if g.exportAsHCL {
+		hclExporter := NewHClExporter(g.resourceTypesHCLBlocks, g.unresolvedAttrs, g.resourceImports, providerSource, g.version, g.exportDirPath, g.splitFilesByResource)
 		err = hclExporter.exportHCLConfig()
 	} else {
-		jsonExporter := NewJsonExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, providerSource, g.version, g.exportDirPath, g.splitFilesByResource)
+		jsonExporter := NewJsonExporter(g.resourceTypesMaps, g.dataSourceTypesMaps, g.unresolvedAttrs, g.resourceImports, providerSource, g.version, g.exportDirPath, g.splitFilesByResource)
 		err = jsonExporter.exportJSONConfig()
 	}
 
@@ -484,12 +575,34 @@ func (g *GenesysCloudResourceExporter) generateOutputFiles() diag.Diagnostics {
 		}
 	}
 
+	err = g.writeResourceNameMap()
+	if err != nil {
+		return err
+	}
+
+	// Compare the exported resources against an existing terraform state file
+	err = g.generateDriftReport()
+	if err != nil {
+		return err
+	}
+
+	// Validate the generated config against the schemas of the provider. Problems are reported as a warning.
+	validationDiags := g.validateExportedConfig()
+	if validationDiags.HasError() {
+		return validationDiags
+	}
+
+	err = g.manifest.write(g.exportDirPath)
+	if err != nil {
+		return err
+	}
+
 	err = g.generateZipForExporter()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return validationDiags
 }
 
 func (g *GenesysCloudResourceExporter) generateZipForExporter() diag.Diagnostics {
@@ -582,7 +695,7 @@ func (g *GenesysCloudResourceExporter) processAndBuildDependencies() (filters []
 			continue
 		}
 
-		resources, dependsStruct, err := proxy.GetAllWithPooledClient(retrieveDependentConsumers(resourceKeys))
+		resources, dependsStruct, err := proxy.GetAllWithPooledClient(g.withClientPool(g.ctx), retrieveDependentConsumers(resourceKeys))
 
 		g.flowResourcesList = append(g.flowResourcesList, resourceKeys.State.ID)
 
@@ -867,11 +980,20 @@ func (g *GenesysCloudResourceExporter) appendResources(resourcesToAdd []resource
 	g.copyResourceAddtoG(existingResources)
 }
 
+// withClientPool attaches the SDK client pool of the provider running the export to ctx
+// so that the exporters' getAll* functions run against the org of that provider instance
+func (g *GenesysCloudResourceExporter) withClientPool(ctx context.Context) context.Context {
+	if providerMeta, ok := g.meta.(*provider.ProviderMeta); ok && providerMeta.ClientPool != nil {
+		return provider.WithSDKClientPool(ctx, providerMeta.ClientPool)
+	}
+	return ctx
+}
+
 func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[string]*resourceExporter.ResourceExporter, filter []string, logErrors bool) diag.Diagnostics {
 	errorChan := make(chan diag.Diagnostics)
 	wgDone := make(chan bool)
 	// Cancel remaining goroutines if an error occurs
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(g.withClientPool(context.Background()))
 	defer cancel()
 
 	var wg sync.WaitGroup
@@ -881,6 +1003,7 @@ func (g *GenesysCloudResourceExporter) buildSanitizedResourceMaps(exporters map[
 			defer wg.Done()
 			log.Printf("Getting all resources for type %s", name)
 			exporter.FilterResource = g.resourceFilter
+			exporter.NameSanitizer = g.resourceNaming.sanitizer(name)
 
 			err := exporter.LoadSanitizedResourceMap(ctx, name, filter)
 
@@ -995,11 +1118,6 @@ func addLogAttrInfoToErrorSummary(err diag.Diagnostics) diag.Diagnostics {
 }
 
 func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provider *schema.Provider, exporter *resourceExporter.ResourceExporter, meta interface{}) ([]resourceExporter.ResourceInfo, diag.Diagnostics) {
-	lenResources := len(exporter.SanitizedResourceMap)
-	errorChan := make(chan diag.Diagnostics, lenResources)
-	resourceChan := make(chan resourceExporter.ResourceInfo, lenResources)
-	removeChan := make(chan string, lenResources)
-
 	res := provider.ResourcesMap[resType]
 
 	if res == nil {
@@ -1007,9 +1125,37 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 	}
 
 	ctyType := res.CoreConfigSchema().ImpliedType()
+
+	// Resources that haven't changed since the previous incremental export don't need to be read again
+	var resources []resourceExporter.ResourceInfo
+	resourcesToRead := make(resourceExporter.ResourceIDMetaMap)
+	for id, resMeta := range exporter.SanitizedResourceMap {
+		isDataSource := g.isDataSource(resType, resMeta.Name)
+		if state := g.previousManifest.unchangedState(resType, id, resMeta, isDataSource); state != nil {
+			g.manifest.add(resType, id, resMeta, state, isDataSource)
+			resources = append(resources, resourceExporter.ResourceInfo{
+				State:    state,
+				Name:     resMeta.Name,
+				Type:     resType,
+				CtyType:  ctyType,
+				ImportId: resMeta.IdPrefix + id,
+			})
+			continue
+		}
+		resourcesToRead[id] = resMeta
+	}
+	if g.incremental {
+		log.Printf("Reusing %d unchanged resources of type %s from the previous export", len(resources), resType)
+	}
+
+	lenResources := len(resourcesToRead)
+	errorChan := make(chan diag.Diagnostics, lenResources)
+	resourceChan := make(chan resourceExporter.ResourceInfo, lenResources)
+	removeChan := make(chan string, lenResources)
+
 	var wg sync.WaitGroup
 	wg.Add(lenResources)
-	for id, resMeta := range exporter.SanitizedResourceMap {
+	for id, resMeta := range resourcesToRead {
 		go func(id string, resMeta *resourceExporter.ResourceMeta) {
 			defer wg.Done()
 			fetchResourceState := func() error {
@@ -1019,7 +1165,8 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 				// will block until it can acquire a pooled client config object.
 				instanceState, err := getResourceState(ctx, res, id, resMeta, meta)
 
-				if g.isDataSource(resType, resMeta.Name) {
+				isDataSource := g.isDataSource(resType, resMeta.Name)
+				if isDataSource {
 					g.exMutex.Lock()
 					res = provider.DataSourcesMap[resType]
 					g.exMutex.Unlock()
@@ -1051,11 +1198,13 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 					return nil
 				}
 
+				g.manifest.add(resType, id, resMeta, instanceState, isDataSource)
 				resourceChan <- resourceExporter.ResourceInfo{
-					State:   instanceState,
-					Name:    resMeta.Name,
-					Type:    resType,
-					CtyType: ctyType,
+					State:    instanceState,
+					Name:     resMeta.Name,
+					Type:     resType,
+					CtyType:  ctyType,
+					ImportId: resMeta.IdPrefix + id,
 				}
 
 				return nil
@@ -1085,7 +1234,6 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 		close(removeChan)
 	}()
 
-	var resources []resourceExporter.ResourceInfo
 	for r := range resourceChan {
 		resources = append(resources, r)
 	}
@@ -1101,8 +1249,12 @@ func (g *GenesysCloudResourceExporter) getResourcesForType(resType string, provi
 	case err := <-errorChan:
 		return nil, err
 	default:
-		return resources, nil
 	}
+
+	if err := g.prefixDivisionNames(resType, exporter, resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
 }
 
 func getResourceState(ctx context.Context, resource *schema.Resource, resID string, resMeta *resourceExporter.ResourceMeta, meta interface{}) (*terraform.InstanceState, diag.Diagnostics) {
@@ -1234,7 +1386,7 @@ func (g *GenesysCloudResourceExporter) sanitizeConfigMap(
 			if phoneNumber, ok := configMap[key].(string); !ok || phoneNumber == "" {
 				continue
 			}
-			configMap[key] = sanitizeE164Number(configMap[key].(string))
+			configMap[key] = util.SanitizeE164Number(configMap[key].(string))
 			continue
 		}
 
@@ -1406,6 +1558,22 @@ func (g *GenesysCloudResourceExporter) resolveValueToDataSource(exporter *resour
 	}
 }
 
+// removeResolvedAttrs drops the variables of unresolvable attributes that no longer reference them, e.g. because the
+// files they point to were written along with the export
+func (g *GenesysCloudResourceExporter) removeResolvedAttrs(resourceType string, resourceName string, configMap util.JsonMap) {
+	unresolvedAttrs := make([]unresolvableAttributeInfo, 0, len(g.unresolvedAttrs))
+	for _, attr := range g.unresolvedAttrs {
+		if attr.ResourceType == resourceType && attr.ResourceName == resourceName {
+			varReference := fmt.Sprintf("${var.%s_%s_%s}", resourceType, resourceName, attr.Name)
+			if value, ok := configMap[attr.Name].(string); ok && value != varReference {
+				continue
+			}
+		}
+		unresolvedAttrs = append(unresolvedAttrs, attr)
+	}
+	g.unresolvedAttrs = unresolvedAttrs
+}
+
 func attrInUnResolvableAttrs(a string, myMap map[string]*schema.Schema) (*schema.Schema, bool) {
 	for k, v := range myMap {
 		if k == a {
@@ -322,7 +322,7 @@ func TestUnitTfExportBuildDependsOnResources(t *testing.T) {
 		return resources, dependencyStruct, nil
 	}
 
-	getAllPooledFn := func(method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
+	getAllPooledFn := func(ctx context.Context, method provider.GetCustomConfigFunc) (resourceExporter.ResourceIDMetaMap, *resourceExporter.DependencyResource, diag.Diagnostics) {
 		//assert.Equal(t, targetName, name)
 		return resources, dependencyStruct, nil
 	}
@@ -578,3 +578,46 @@ func getMockCampaignConfig(originalValueOfScriptId string) map[string]any {
 
 	return config
 }
+
+func TestUnitTfExportRemoveResolvedAttrs(t *testing.T) {
+	gre := &GenesysCloudResourceExporter{
+		unresolvedAttrs: []unresolvableAttributeInfo{
+			{ResourceType: "genesyscloud_flow", ResourceName: "exported", Name: "filepath"},
+			{ResourceType: "genesyscloud_flow", ResourceName: "failed", Name: "filepath"},
+		},
+	}
+
+	// The flow definition was written with the export
+	gre.removeResolvedAttrs("genesyscloud_flow", "exported", util.JsonMap{"filepath": "flows/flow-1.yaml"})
+	// The flow definition could not be exported, so the variable is still used
+	gre.removeResolvedAttrs("genesyscloud_flow", "failed", util.JsonMap{"filepath": "${var.genesyscloud_flow_failed_filepath}"})
+
+	assert.Equal(t, []unresolvableAttributeInfo{{ResourceType: "genesyscloud_flow", ResourceName: "failed", Name: "filepath"}}, gre.unresolvedAttrs)
+}
+
+func TestUnitTfExportRemoveReplacedExporters(t *testing.T) {
+	newExports := func() map[string]*resourceExporter.ResourceExporter {
+		return map[string]*resourceExporter.ResourceExporter{
+			"genesyscloud_architect_datatable":      {},
+			"genesyscloud_architect_datatable_row":  {},
+			"genesyscloud_architect_datatable_rows": {ReplacesResourceTypes: []string{"genesyscloud_architect_datatable_row"}},
+		}
+	}
+	exportedTypes := func(exports map[string]*resourceExporter.ResourceExporter) []string {
+		var types []string
+		for resType := range exports {
+			types = append(types, resType)
+		}
+		return types
+	}
+
+	// Not included, so the replacing resource type is left out
+	exports := newExports()
+	removeReplacedExporters(exports, nil)
+	assert.ElementsMatch(t, []string{"genesyscloud_architect_datatable", "genesyscloud_architect_datatable_row"}, exportedTypes(exports))
+
+	// Explicitly included, so the replaced resource type is left out
+	exports = newExports()
+	removeReplacedExporters(exports, []string{"genesyscloud_architect_datatable", "genesyscloud_architect_datatable_row", "genesyscloud_architect_datatable_rows"})
+	assert.ElementsMatch(t, []string{"genesyscloud_architect_datatable", "genesyscloud_architect_datatable_rows"}, exportedTypes(exports))
+}
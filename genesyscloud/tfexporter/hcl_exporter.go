@@ -23,16 +23,18 @@ type resourceHCLBlock [][]byte
 type HCLExporter struct {
 	resourceTypesHCLBlocks map[string]resourceHCLBlock
 	unresolvedAttrs        []unresolvableAttributeInfo
+	resourceImports        map[string][]resourceImport
 	providerSource         string
 	version                string
 	dirPath                string
 	splitFilesByResource   bool
 }
 
-func NewHClExporter(resourceTypesHCLBlocks map[string]resourceHCLBlock, unresolvedAttrs []unresolvableAttributeInfo, providerSource string, version string, dirPath string, splitFilesByResource bool) *HCLExporter {
+func NewHClExporter(resourceTypesHCLBlocks map[string]resourceHCLBlock, unresolvedAttrs []unresolvableAttributeInfo, resourceImports map[string][]resourceImport, providerSource string, version string, dirPath string, splitFilesByResource bool) *HCLExporter {
 	hclExporter := &HCLExporter{
 		resourceTypesHCLBlocks: resourceTypesHCLBlocks,
 		unresolvedAttrs:        unresolvedAttrs,
+		resourceImports:        resourceImports,
 		providerSource:         providerSource,
 		version:                version,
 		dirPath:                dirPath,
@@ -93,6 +95,13 @@ func (h *HCLExporter) exportHCLConfig() diag.Diagnostics {
 		}
 	}
 
+	// Optional import blocks for the exported resources
+	if h.resourceImports != nil {
+		if diagErr := writeHCLImports(h.resourceImports, h.dirPath, h.splitFilesByResource); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	// Optional tfvars file creation for unresolved attributes
 	if len(h.unresolvedAttrs) > 0 {
 		tfVars := make(map[string]interface{})
@@ -165,15 +174,20 @@ func createHCLVariablesBlock(unresolvedAttrs []unresolvableAttributeInfo) []byte
 }
 
 func postProcessHclBytes(resource []byte) []byte {
-	resourceStr := string(resource)
-	for placeholderId, val := range attributesDecoded {
-		resourceStr = strings.Replace(resourceStr, fmt.Sprintf("\"%s\"", placeholderId), val, -1)
-	}
+	resourceStr := replaceDecodedAttributes(string(resource), attributesDecoded)
 
 	resourceStr = correctCustomFunctions(resourceStr)
 	return []byte(resourceStr)
 }
 
+// replaceDecodedAttributes replaces the placeholders of JSON encoded attributes with their jsonencode expressions
+func replaceDecodedAttributes(resourceStr string, decoded map[string]string) string {
+	for placeholderId, val := range decoded {
+		resourceStr = strings.Replace(resourceStr, fmt.Sprintf("\"%s\"", placeholderId), val, -1)
+	}
+	return resourceStr
+}
+
 func writeHCLToFile(bytes [][]byte, path string) diag.Diagnostics {
 	// clear contents
 	_ = os.WriteFile(path, nil, os.ModePerm)
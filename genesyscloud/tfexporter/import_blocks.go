@@ -0,0 +1,129 @@
+package tfexporter
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	zclconfCty "github.com/zclconf/go-cty/cty"
+)
+
+/*
+This file contains the functions used to write Terraform 1.5+ import blocks for the exported resources. The import
+blocks are written to their own file next to the config, or to one file per resource type when the export is split.
+*/
+const (
+	defaultTfHCLImportsFile  = "imports.tf"
+	defaultTfJSONImportsFile = "imports.tf.json"
+)
+
+// resourceImport is the target address and import ID of one exported resource
+type resourceImport struct {
+	Type string
+	Name string
+	Id   string
+
+	// Module the resource is exported to, if the export is modularized
+	Module string
+}
+
+func newResourceImport(resource resourceExporter.ResourceInfo) resourceImport {
+	id := resource.ImportId
+	if id == "" {
+		id = resource.State.ID
+	}
+	return resourceImport{Type: resource.Type, Name: resource.Name, Id: id}
+}
+
+func (r resourceImport) address() string {
+	return r.Type + "." + r.Name
+}
+
+func (r resourceImport) target() string {
+	if r.Module == "" {
+		return r.address()
+	}
+	return "module." + r.Module + "." + r.address()
+}
+
+// sortedResourceImports returns the resource types in alphabetical order with their imports ordered by address,
+// so that the files don't change between exports of the same org
+func sortedResourceImports(resourceImports map[string][]resourceImport) ([]string, map[string][]resourceImport) {
+	resTypes := make([]string, 0, len(resourceImports))
+	sorted := make(map[string][]resourceImport, len(resourceImports))
+	for resType, imports := range resourceImports {
+		resTypes = append(resTypes, resType)
+		sorted[resType] = append([]resourceImport(nil), imports...)
+		sort.Slice(sorted[resType], func(i, j int) bool { return sorted[resType][i].Name < sorted[resType][j].Name })
+	}
+	sort.Strings(resTypes)
+	return resTypes, sorted
+}
+
+// Create the HCL import blocks for the resources of one type
+func createHCLImportBlocks(imports []resourceImport) []byte {
+	f := hclwrite.NewEmptyFile()
+	for i, resImport := range imports {
+		if i > 0 {
+			f.Body().AppendNewline()
+		}
+		body := f.Body().AppendNewBlock("import", nil).Body()
+		body.SetAttributeTraversal("to", traversalFor(resImport.target()))
+		body.SetAttributeValue("id", zclconfCty.StringVal(resImport.Id))
+	}
+	return f.Bytes()
+}
+
+func createImportsJsonList(imports []resourceImport) []util.JsonMap {
+	importList := make([]util.JsonMap, 0, len(imports))
+	for _, resImport := range imports {
+		importList = append(importList, util.JsonMap{
+			"to": resImport.target(),
+			"id": resImport.Id,
+		})
+	}
+	return importList
+}
+
+func writeHCLImports(resourceImports map[string][]resourceImport, dirPath string, splitFilesByResource bool) diag.Diagnostics {
+	resTypes, sorted := sortedResourceImports(resourceImports)
+	if splitFilesByResource {
+		for _, resType := range resTypes {
+			path := filepath.Join(dirPath, fmt.Sprintf("%s_imports.%s", resType, resourceHCLFileExt))
+			if diagErr := writeHCLToFile([][]byte{createHCLImportBlocks(sorted[resType])}, path); diagErr != nil {
+				return diagErr
+			}
+		}
+		return nil
+	}
+
+	blocks := make([][]byte, 0, len(resTypes))
+	for _, resType := range resTypes {
+		blocks = append(blocks, createHCLImportBlocks(sorted[resType]))
+	}
+	return writeHCLToFile(blocks, filepath.Join(dirPath, defaultTfHCLImportsFile))
+}
+
+func writeJSONImports(resourceImports map[string][]resourceImport, dirPath string, splitFilesByResource bool) diag.Diagnostics {
+	resTypes, sorted := sortedResourceImports(resourceImports)
+	if splitFilesByResource {
+		for _, resType := range resTypes {
+			path := filepath.Join(dirPath, fmt.Sprintf("%s_imports.%s", resType, resourceJSONFileExt))
+			if diagErr := writeConfig(util.JsonMap{"import": createImportsJsonList(sorted[resType])}, path); diagErr != nil {
+				return diagErr
+			}
+		}
+		return nil
+	}
+
+	importList := make([]util.JsonMap, 0)
+	for _, resType := range resTypes {
+		importList = append(importList, createImportsJsonList(sorted[resType])...)
+	}
+	return writeConfig(util.JsonMap{"import": importList}, filepath.Join(dirPath, defaultTfJSONImportsFile))
+}
@@ -0,0 +1,84 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testResourceImports() map[string][]resourceImport {
+	return map[string][]resourceImport{
+		"genesyscloud_user": {
+			newResourceImport(resourceExporter.ResourceInfo{Type: "genesyscloud_user", Name: "user_b", State: &terraform.InstanceState{ID: "user-b-id"}}),
+			newResourceImport(resourceExporter.ResourceInfo{Type: "genesyscloud_user", Name: "user_a", State: &terraform.InstanceState{ID: "user-a-id"}}),
+		},
+		"genesyscloud_architect_schedules": {
+			newResourceImport(resourceExporter.ResourceInfo{
+				Type:     "genesyscloud_architect_schedules",
+				Name:     "schedule",
+				State:    &terraform.InstanceState{ID: "schedule-id"},
+				ImportId: "prefix:schedule-id",
+			}),
+		},
+	}
+}
+
+func TestUnitTfExportHCLImportBlocks(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, writeHCLImports(testResourceImports(), dir, false))
+
+	contents, err := os.ReadFile(filepath.Join(dir, defaultTfHCLImportsFile))
+	assert.Nil(t, err)
+	assert.Equal(t, `import {
+  to = genesyscloud_architect_schedules.schedule
+  id = "prefix:schedule-id"
+}
+
+import {
+  to = genesyscloud_user.user_a
+  id = "user-a-id"
+}
+
+import {
+  to = genesyscloud_user.user_b
+  id = "user-b-id"
+}
+
+`, string(contents))
+}
+
+func TestUnitTfExportHCLImportBlocksSplitByResource(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, writeHCLImports(testResourceImports(), dir, true))
+
+	_, err := os.Stat(filepath.Join(dir, defaultTfHCLImportsFile))
+	assert.True(t, os.IsNotExist(err))
+
+	contents, err := os.ReadFile(filepath.Join(dir, "genesyscloud_architect_schedules_imports.tf"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(contents), `id = "prefix:schedule-id"`)
+	assert.NotContains(t, string(contents), "genesyscloud_user")
+}
+
+func TestUnitTfExportJSONImportBlocks(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, writeJSONImports(testResourceImports(), dir, false))
+
+	contents, err := os.ReadFile(filepath.Join(dir, defaultTfJSONImportsFile))
+	assert.Nil(t, err)
+	var imports struct {
+		Import []map[string]string `json:"import"`
+	}
+	assert.Nil(t, json.Unmarshal(contents, &imports))
+	assert.Equal(t, []map[string]string{
+		{"to": "genesyscloud_architect_schedules.schedule", "id": "prefix:schedule-id"},
+		{"to": "genesyscloud_user.user_a", "id": "user-a-id"},
+		{"to": "genesyscloud_user.user_b", "id": "user-b-id"},
+	}, imports.Import)
+}
@@ -23,17 +23,19 @@ type JsonExporter struct {
 	resourceTypesJSONMaps map[string]resourceJSONMaps
 	dataSourceTypesMaps   map[string]resourceJSONMaps
 	unresolvedAttrs       []unresolvableAttributeInfo
+	resourceImports       map[string][]resourceImport
 	providerSource        string
 	version               string
 	dirPath               string
 	splitFilesByResource  bool
 }
 
-func NewJsonExporter(resourceTypesJSONMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, providerSource string, version string, dirPath string, splitFilesByResource bool) *JsonExporter {
+func NewJsonExporter(resourceTypesJSONMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, resourceImports map[string][]resourceImport, providerSource string, version string, dirPath string, splitFilesByResource bool) *JsonExporter {
 	jsonExporter := &JsonExporter{
 		resourceTypesJSONMaps: resourceTypesJSONMaps,
 		dataSourceTypesMaps:   dataSourceTypesMaps,
 		unresolvedAttrs:       unresolvedAttrs,
+		resourceImports:       resourceImports,
 		providerSource:        providerSource,
 		version:               version,
 		dirPath:               dirPath,
@@ -128,6 +130,13 @@ func (j *JsonExporter) exportJSONConfig() diag.Diagnostics {
 		writeConfig(rootJSONObject, jsonFilePath)
 	}
 
+	// Optional import blocks for the exported resources
+	if j.resourceImports != nil {
+		if diagErr := writeJSONImports(j.resourceImports, j.dirPath, j.splitFilesByResource); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	// Optional tfvars file creation for unresolved attributes
 	if len(j.unresolvedAttrs) > 0 {
 		tfVars := make(map[string]interface{})
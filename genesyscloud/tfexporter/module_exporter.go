@@ -0,0 +1,503 @@
+package tfexporter
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	zclconfCty "github.com/zclconf/go-cty/cty"
+)
+
+/*
+This file contains all of the functions used to export the config as reusable Terraform modules. Resources are grouped
+into one module per resource type or per division. Values that differ between orgs (division IDs, phone numbers, email
+domains and integration action URLs) are lifted into root variables with a tfvars file per environment, and references
+between modules are passed through module outputs.
+*/
+const (
+	moduleGroupingResourceType = "resource_type"
+	moduleGroupingDivision     = "division"
+
+	defaultModulesDir       = "modules"
+	defaultEnvironmentsDir  = "environments"
+	defaultTfHCLMainFile    = "main.tf"
+	defaultTfHCLOutputsFile = "outputs.tf"
+	defaultTfHCLVersionFile = "versions.tf"
+
+	commonModuleName = "common"
+)
+
+var (
+	// Matches '${genesyscloud_x.name.attr}' and '${data.genesyscloud_x.name.attr}', and the escaped '$${...}' so it can be skipped
+	moduleReferenceRegex = regexp.MustCompile(`\$?\$\{((?:data\.)?genesyscloud_[a-z0-9_]+\.[A-Za-z0-9_\-]+)\.([A-Za-z0-9_]+)\}`)
+	moduleVariableRegex  = regexp.MustCompile(`\$?\$\{var\.([A-Za-z0-9_\-]+)`)
+	guidRegex            = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	emailRegex           = regexp.MustCompile(`^([^@\s$]+)@([A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)+)$`)
+	urlHostRegex         = regexp.MustCompile(`^(https?://[^/$]+)(.*)$`)
+	variableNameRegex    = regexp.MustCompile(`[^A-Za-z0-9_]`)
+)
+
+// Resource types whose URLs point at environment specific services
+var moduleUrlResourceTypes = []string{"genesyscloud_integration_action"}
+
+type exportModule struct {
+	name        string
+	resources   map[string]resourceJSONMaps
+	dataSources map[string]resourceJSONMaps
+
+	// Module variable -> expression the root module passes to it
+	inputs map[string]string
+
+	// Module output -> expression of the output value
+	outputs map[string]string
+}
+
+// liftedVariable is a root variable holding a value that differs between environments
+type liftedVariable struct {
+	description string
+	value       string
+}
+
+type ModuleExporter struct {
+	resourceTypesJSONMaps map[string]resourceJSONMaps
+	dataSourceTypesMaps   map[string]resourceJSONMaps
+	unresolvedAttrs       []unresolvableAttributeInfo
+	resourceImports       map[string][]resourceImport
+	exporters             map[string]*resourceExporter.ResourceExporter
+	providerSource        string
+	version               string
+	dirPath               string
+	groupBy               string
+	environments          []string
+
+	modules        map[string]*exportModule
+	addressModules map[string]string
+	liftedVars     map[string]liftedVariable
+
+	// Copy of attributesDecoded with the references rewritten for the modules, so that other exports aren't affected
+	attributesDecoded map[string]string
+}
+
+func NewModuleExporter(resourceTypesJSONMaps map[string]resourceJSONMaps, dataSourceTypesMaps map[string]resourceJSONMaps, unresolvedAttrs []unresolvableAttributeInfo, resourceImports map[string][]resourceImport, exporters map[string]*resourceExporter.ResourceExporter, providerSource string, version string, dirPath string, groupBy string, environments []string) *ModuleExporter {
+	moduleExporter := &ModuleExporter{
+		resourceTypesJSONMaps: resourceTypesJSONMaps,
+		dataSourceTypesMaps:   dataSourceTypesMaps,
+		unresolvedAttrs:       unresolvedAttrs,
+		resourceImports:       resourceImports,
+		exporters:             exporters,
+		providerSource:        providerSource,
+		version:               version,
+		dirPath:               dirPath,
+		groupBy:               groupBy,
+		environments:          environments,
+		modules:               make(map[string]*exportModule),
+		addressModules:        make(map[string]string),
+		liftedVars:            make(map[string]liftedVariable),
+		attributesDecoded:     make(map[string]string, len(attributesDecoded)),
+	}
+	for placeholderId, decoded := range attributesDecoded {
+		moduleExporter.attributesDecoded[placeholderId] = decoded
+	}
+	return moduleExporter
+}
+
+func (m *ModuleExporter) exportModules() diag.Diagnostics {
+	m.assignModules()
+
+	for _, moduleName := range m.sortedModuleNames() {
+		module := m.modules[moduleName]
+		for resType, resMaps := range module.resources {
+			for resName, configMap := range resMaps {
+				m.liftEnvironmentValues(resType, resName, configMap)
+				m.rewriteReferences(module, resType+"."+resName, configMap)
+			}
+		}
+		for resType, resMaps := range module.dataSources {
+			for resName, configMap := range resMaps {
+				m.rewriteReferences(module, "data."+resType+"."+resName, configMap)
+			}
+		}
+	}
+
+	for _, moduleName := range m.sortedModuleNames() {
+		if diagErr := m.writeModule(m.modules[moduleName]); diagErr != nil {
+			return diagErr
+		}
+	}
+	return m.writeRootModule()
+}
+
+// assignModules decides which module every resource and data source belongs to
+func (m *ModuleExporter) assignModules() {
+	add := func(moduleName string, resType string, resName string, configMap util.JsonMap, isDataSource bool) {
+		module, ok := m.modules[moduleName]
+		if !ok {
+			module = &exportModule{
+				name:        moduleName,
+				resources:   make(map[string]resourceJSONMaps),
+				dataSources: make(map[string]resourceJSONMaps),
+				inputs:      make(map[string]string),
+				outputs:     make(map[string]string),
+			}
+			m.modules[moduleName] = module
+		}
+
+		target, address := module.resources, resType+"."+resName
+		if isDataSource {
+			target, address = module.dataSources, "data."+resType+"."+resName
+		}
+		if target[resType] == nil {
+			target[resType] = make(resourceJSONMaps)
+		}
+		target[resType][resName] = configMap
+		m.addressModules[address] = moduleName
+	}
+
+	for resType, resMaps := range m.resourceTypesJSONMaps {
+		for resName, configMap := range resMaps {
+			add(m.moduleNameFor(resType, resName, configMap), resType, resName, configMap, false)
+		}
+	}
+	for resType, resMaps := range m.dataSourceTypesMaps {
+		for resName, configMap := range resMaps {
+			moduleName := commonModuleName
+			if m.groupBy == moduleGroupingResourceType {
+				moduleName = sanitizeModuleName(resType)
+			}
+			add(moduleName, resType, resName, configMap, true)
+		}
+	}
+}
+
+func (m *ModuleExporter) moduleNameFor(resType string, resName string, configMap util.JsonMap) string {
+	if m.groupBy == moduleGroupingResourceType {
+		return sanitizeModuleName(resType)
+	}
+
+	if resType == "genesyscloud_auth_division" {
+		return sanitizeModuleName("division_" + resName)
+	}
+	if divisionId, ok := configMap["division_id"].(string); ok {
+		if match := moduleReferenceRegex.FindStringSubmatch(divisionId); match != nil && !strings.HasPrefix(match[0], "$$") {
+			address := strings.Split(strings.TrimPrefix(match[1], "data."), ".")
+			if address[0] == "genesyscloud_auth_division_home" {
+				return "division_home"
+			}
+			return sanitizeModuleName("division_" + address[1])
+		}
+	}
+	return commonModuleName
+}
+
+// liftEnvironmentValues replaces the values of a resource that differ between orgs with root variables
+func (m *ModuleExporter) liftEnvironmentValues(resType string, resName string, configMap util.JsonMap) {
+	exporter := m.exporters[resType]
+	walkConfigStrings("", configMap, func(path string, value string) string {
+		if hasInterpolation(value) {
+			return value
+		}
+
+		attr := path[strings.LastIndex(path, ".")+1:]
+		if (attr == "division_id" || attr == "division_ids") && guidRegex.MatchString(value) {
+			return m.liftValue(variableName("division_"+value), fmt.Sprintf("ID of a division used by %s.%s", resType, resName), value)
+		}
+
+		if exporter != nil && exporter.IsAttributeE164(path) && value != "" {
+			return m.liftValue(variableName(fmt.Sprintf("%s_%s_%s", resType, resName, path)), fmt.Sprintf("Phone number %s of %s.%s", path, resType, resName), value)
+		}
+
+		if match := emailRegex.FindStringSubmatch(value); match != nil {
+			domain := match[2]
+			return match[1] + "@" + m.liftValue(variableName("email_domain_"+domain), "Email domain "+domain, domain)
+		}
+
+		if lists.ItemInSlice(resType, moduleUrlResourceTypes) {
+			if match := urlHostRegex.FindStringSubmatch(value); match != nil {
+				host := match[1]
+				return m.liftValue(variableName("url_"+strings.SplitN(host, "://", 2)[1]), "Base URL "+host, host) + match[2]
+			}
+		}
+		return value
+	})
+}
+
+func (m *ModuleExporter) liftValue(name string, description string, value string) string {
+	if _, ok := m.liftedVars[name]; !ok {
+		m.liftedVars[name] = liftedVariable{description: description, value: value}
+	}
+	return fmt.Sprintf("${var.%s}", name)
+}
+
+// rewriteReferences points references to resources of other modules at module variables and records the module
+// outputs and root variables each module needs
+func (m *ModuleExporter) rewriteReferences(module *exportModule, address string, configMap util.JsonMap) {
+	rewrite := func(value string) string {
+		value = moduleReferenceRegex.ReplaceAllStringFunc(value, func(reference string) string {
+			if strings.HasPrefix(reference, "$$") {
+				return reference
+			}
+			match := moduleReferenceRegex.FindStringSubmatch(reference)
+			refModule, ok := m.addressModules[match[1]]
+			if !ok || refModule == module.name {
+				return reference
+			}
+
+			name := variableName(match[1] + "_" + match[2])
+			m.modules[refModule].outputs[name] = match[1] + "." + match[2]
+			module.inputs[name] = "module." + refModule + "." + name
+			return fmt.Sprintf("${var.%s}", name)
+		})
+
+		for _, match := range moduleVariableRegex.FindAllStringSubmatch(value, -1) {
+			if strings.HasPrefix(match[0], "$$") {
+				continue
+			}
+			if _, ok := module.inputs[match[1]]; !ok {
+				module.inputs[match[1]] = "var." + match[1]
+			}
+		}
+		return value
+	}
+
+	walkConfigStrings("", configMap, func(_ string, value string) string {
+		// JSON encoded attributes of HCL exports are stored under a placeholder until the file is written
+		if decoded, ok := m.attributesDecoded[value]; ok {
+			m.attributesDecoded[value] = rewrite(decoded)
+			return value
+		}
+		return rewrite(value)
+	})
+
+	// Resources can't depend on resources of other modules directly. The module variables already order them.
+	if dependsOn, ok := configMap["depends_on"].([]string); ok {
+		kept := make([]string, 0, len(dependsOn))
+		for _, dependency := range dependsOn {
+			dependencyAddress := strings.TrimSuffix(strings.TrimPrefix(dependency, "$dep$"), "$dep$")
+			if refModule, ok := m.addressModules[dependencyAddress]; ok && refModule != module.name {
+				log.Printf("Dropping depends_on %s of %s as it is in module %s", dependencyAddress, address, refModule)
+				continue
+			}
+			kept = append(kept, dependency)
+		}
+		configMap["depends_on"] = kept
+		if len(kept) == 0 {
+			delete(configMap, "depends_on")
+		}
+	}
+}
+
+func (m *ModuleExporter) writeModule(module *exportModule) diag.Diagnostics {
+	moduleDir := filepath.Join(m.dirPath, defaultModulesDir, module.name)
+	if err := os.MkdirAll(moduleDir, os.ModePerm); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diagErr := writeHCLToFile([][]byte{createHCLProviderBlock(m.providerSource, m.version)}, filepath.Join(moduleDir, defaultTfHCLVersionFile)); diagErr != nil {
+		return diagErr
+	}
+
+	blocks := make([][]byte, 0)
+	for _, resType := range sortedKeys(module.dataSources) {
+		for _, resName := range sortedKeys(module.dataSources[resType]) {
+			blocks = append(blocks, m.hclBlock(resType, resName, module.dataSources[resType][resName], true))
+		}
+	}
+	for _, resType := range sortedKeys(module.resources) {
+		for _, resName := range sortedKeys(module.resources[resType]) {
+			blocks = append(blocks, m.hclBlock(resType, resName, module.resources[resType][resName], false))
+		}
+	}
+	if diagErr := writeHCLToFile(blocks, filepath.Join(moduleDir, defaultTfHCLMainFile)); diagErr != nil {
+		return diagErr
+	}
+
+	variablesFile := hclwrite.NewEmptyFile()
+	for _, name := range sortedKeys(module.inputs) {
+		variablesFile.Body().AppendNewBlock("variable", []string{name})
+	}
+	if diagErr := writeHCLToFile([][]byte{variablesFile.Bytes()}, filepath.Join(moduleDir, defaultTfHCLVariablesFile)); diagErr != nil {
+		return diagErr
+	}
+
+	outputsFile := hclwrite.NewEmptyFile()
+	for _, name := range sortedKeys(module.outputs) {
+		outputsFile.Body().AppendNewBlock("output", []string{name}).Body().SetAttributeTraversal("value", traversalFor(module.outputs[name]))
+	}
+	return writeHCLToFile([][]byte{outputsFile.Bytes()}, filepath.Join(moduleDir, defaultTfHCLOutputsFile))
+}
+
+// hclBlock converts a resource to HCL with the JSON encoded attributes rewritten for the module
+func (m *ModuleExporter) hclBlock(resType string, resName string, configMap util.JsonMap, isDataSource bool) []byte {
+	block := instanceStateToHCLBlock(resType, resName, configMap, isDataSource)
+	return []byte(replaceDecodedAttributes(string(block), m.attributesDecoded))
+}
+
+func (m *ModuleExporter) writeRootModule() diag.Diagnostics {
+	mainFile := hclwrite.NewEmptyFile()
+	for i, moduleName := range m.sortedModuleNames() {
+		if i > 0 {
+			mainFile.Body().AppendNewline()
+		}
+		module := m.modules[moduleName]
+		body := mainFile.Body().AppendNewBlock("module", []string{moduleName}).Body()
+		body.SetAttributeValue("source", zclconfCty.StringVal("./"+defaultModulesDir+"/"+moduleName))
+		for _, name := range sortedKeys(module.inputs) {
+			body.SetAttributeTraversal(name, traversalFor(module.inputs[name]))
+		}
+	}
+	providerBlock := createHCLProviderBlock(m.providerSource, m.version)
+	if diagErr := writeHCLToFile([][]byte{providerBlock, mainFile.Bytes()}, filepath.Join(m.dirPath, defaultTfHCLMainFile)); diagErr != nil {
+		return diagErr
+	}
+
+	variablesFile := hclwrite.NewEmptyFile()
+	for _, name := range sortedKeys(m.liftedVars) {
+		body := variablesFile.Body().AppendNewBlock("variable", []string{name}).Body()
+		body.SetAttributeValue("description", zclconfCty.StringVal(m.liftedVars[name].description))
+		body.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+	}
+	variablesBlocks := [][]byte{variablesFile.Bytes(), createHCLVariablesBlock(m.unresolvedAttrs)}
+	if diagErr := writeHCLToFile(variablesBlocks, filepath.Join(m.dirPath, defaultTfHCLVariablesFile)); diagErr != nil {
+		return diagErr
+	}
+
+	if m.resourceImports != nil {
+		moduleImports := make(map[string][]resourceImport)
+		for resType, imports := range m.resourceImports {
+			for _, resImport := range imports {
+				resImport.Module = m.addressModules[resImport.address()]
+				moduleImports[resType] = append(moduleImports[resType], resImport)
+			}
+		}
+		if diagErr := writeHCLImports(moduleImports, m.dirPath, false); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	return m.writeTfVars()
+}
+
+// writeTfVars writes the values of the exported org to terraform.tfvars. Every environment also gets a tfvars file
+// with the values that differ between orgs left empty, to be filled in with the values of that org.
+func (m *ModuleExporter) writeTfVars() diag.Diagnostics {
+	tfVars := make(map[string]interface{})
+	for name, variable := range m.liftedVars {
+		tfVars[name] = variable.value
+	}
+	for _, attr := range m.unresolvedAttrs {
+		tfVars[createUnresolvedAttrKey(attr)] = determineVarValue(attr.Schema)
+	}
+	if len(tfVars) == 0 {
+		return nil
+	}
+
+	if diagErr := writeTfVars(tfVars, filepath.Join(m.dirPath, defaultTfVarsFile)); diagErr != nil {
+		return diagErr
+	}
+	if len(m.environments) == 0 || len(m.liftedVars) == 0 {
+		return nil
+	}
+
+	environmentsDir := filepath.Join(m.dirPath, defaultEnvironmentsDir)
+	if err := os.MkdirAll(environmentsDir, os.ModePerm); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, environment := range m.environments {
+		if diagErr := m.writeEnvironmentTfVars(environment, filepath.Join(environmentsDir, environment+".tfvars")); diagErr != nil {
+			return diagErr
+		}
+	}
+	return nil
+}
+
+// writeEnvironmentTfVars writes the variables lifted from the exported org with empty values. The values are left
+// empty rather than copied so that applying a file that wasn't filled in fails instead of using the exported org's IDs.
+func (m *ModuleExporter) writeEnvironmentTfVars(environment string, path string) diag.Diagnostics {
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf("// This file has been autogenerated. Set the values of the %s org and pass it with -var-file.\n", environment))
+	content.WriteString(fmt.Sprintf("// The values of the exported org are in %s.\n", defaultTfVarsFile))
+	for _, name := range sortedKeys(m.liftedVars) {
+		content.WriteString(fmt.Sprintf("\n// %s, \"%s\" in the exported org\n%s = \"\"\n", m.liftedVars[name].description, m.liftedVars[name].value, name))
+	}
+
+	log.Printf("Writing %s tfvars file to %s", environment, path)
+	return files.WriteToFile([]byte(content.String()), path)
+}
+
+func (m *ModuleExporter) sortedModuleNames() []string {
+	return sortedKeys(m.modules)
+}
+
+// walkConfigStrings calls fn with the attribute path and value of every string in a config map and replaces the
+// value with the result. Paths don't include list indexes, the same as the attribute paths of a ResourceExporter.
+func walkConfigStrings(path string, value interface{}, fn func(path string, value string) string) interface{} {
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+
+	switch v := value.(type) {
+	case util.JsonMap:
+		walkConfigStrings(path, map[string]interface{}(v), fn)
+	case map[string]interface{}:
+		for key, nested := range v {
+			if key == "depends_on" && path == "" {
+				continue
+			}
+			v[key] = walkConfigStrings(join(key), nested, fn)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = walkConfigStrings(path, nested, fn)
+		}
+	case string:
+		return fn(path, v)
+	}
+	return value
+}
+
+// hasInterpolation is true if a value contains a '${' that isn't escaped as '$${'
+func hasInterpolation(value string) bool {
+	return strings.Contains(strings.ReplaceAll(value, "$${", ""), "${")
+}
+
+// traversalFor builds the traversal of an expression such as 'module.users.user_id'
+func traversalFor(expression string) hcl.Traversal {
+	parts := strings.Split(expression, ".")
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: parts[0]}}
+	for _, part := range parts[1:] {
+		traversal = append(traversal, hcl.TraverseAttr{Name: part})
+	}
+	return traversal
+}
+
+func sanitizeModuleName(name string) string {
+	return variableName(strings.TrimPrefix(name, "genesyscloud_"))
+}
+
+func variableName(name string) string {
+	return variableNameRegex.ReplaceAllString(name, "_")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,137 @@
+package tfexporter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testModuleExporter(t *testing.T, groupBy string, resourceImports map[string][]resourceImport) *ModuleExporter {
+	resourceTypesMaps := map[string]resourceJSONMaps{
+		"genesyscloud_auth_division": {
+			"sales": util.JsonMap{"name": "Sales"},
+		},
+		"genesyscloud_routing_wrapupcode": {
+			"done": util.JsonMap{"name": "Done", "division_id": "5b3d5d8a-7c4e-4bd5-9a3e-0c1f2d3e4f50"},
+		},
+		"genesyscloud_routing_queue": {
+			"support": util.JsonMap{
+				"name":         "Support",
+				"division_id":  "${genesyscloud_auth_division.sales.id}",
+				"wrapup_codes": []interface{}{"${genesyscloud_routing_wrapupcode.done.id}"},
+			},
+		},
+		"genesyscloud_user": {
+			"jane": util.JsonMap{
+				"email":       "jane@example.com",
+				"division_id": "${data.genesyscloud_auth_division_home.home.id}",
+				"addresses": []interface{}{map[string]interface{}{
+					"phone_numbers": []interface{}{map[string]interface{}{"number": "+13175550100"}},
+				}},
+			},
+		},
+		"genesyscloud_integration_action": {
+			"lookup": util.JsonMap{
+				"name": "Lookup",
+				"config_request": []interface{}{map[string]interface{}{
+					"request_url_template": "https://api.example.com/v1/users/$${input.userId}",
+				}},
+			},
+		},
+	}
+	dataSourceTypesMaps := map[string]resourceJSONMaps{
+		"genesyscloud_auth_division_home": {"home": util.JsonMap{}},
+	}
+	exporters := map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_user": {CustomValidateExports: map[string][]string{"E164": {"addresses.phone_numbers.number"}}},
+	}
+	return NewModuleExporter(resourceTypesMaps, dataSourceTypesMaps, nil, resourceImports, exporters, "mypurecloud/genesyscloud", "1.0.0", t.TempDir(), groupBy, []string{"dev", "prod"})
+}
+
+func readExportFile(t *testing.T, path ...string) string {
+	contents, err := os.ReadFile(filepath.Join(path...))
+	assert.Nil(t, err)
+	return string(contents)
+}
+
+func TestUnitTfExportModulesByDivision(t *testing.T) {
+	resourceImports := map[string][]resourceImport{
+		"genesyscloud_routing_queue": {{Type: "genesyscloud_routing_queue", Name: "support", Id: "queue-id"}},
+	}
+	m := testModuleExporter(t, moduleGroupingDivision, resourceImports)
+	assert.Nil(t, m.exportModules())
+
+	// Resources are grouped by the division they reference
+	salesMain := readExportFile(t, m.dirPath, defaultModulesDir, "division_sales", defaultTfHCLMainFile)
+	assert.Contains(t, salesMain, `resource "genesyscloud_auth_division" "sales"`)
+	assert.Contains(t, salesMain, `resource "genesyscloud_routing_queue" "support"`)
+	assert.Contains(t, salesMain, `"${genesyscloud_auth_division.sales.id}"`, "references within a module are kept")
+	assert.Contains(t, readExportFile(t, m.dirPath, defaultModulesDir, "division_home", defaultTfHCLMainFile), `resource "genesyscloud_user" "jane"`)
+	commonMain := readExportFile(t, m.dirPath, defaultModulesDir, commonModuleName, defaultTfHCLMainFile)
+	assert.Contains(t, commonMain, `resource "genesyscloud_routing_wrapupcode" "done"`)
+	assert.Contains(t, commonMain, `data "genesyscloud_auth_division_home" "home"`)
+
+	// References between modules go through outputs and variables
+	assert.Contains(t, salesMain, `"${var.genesyscloud_routing_wrapupcode_done_id}"`)
+	assert.Contains(t, readExportFile(t, m.dirPath, defaultModulesDir, commonModuleName, defaultTfHCLOutputsFile), `output "genesyscloud_routing_wrapupcode_done_id" {
+  value = genesyscloud_routing_wrapupcode.done.id
+}`)
+	assert.Contains(t, readExportFile(t, m.dirPath, defaultModulesDir, "division_sales", defaultTfHCLVariablesFile), `variable "genesyscloud_routing_wrapupcode_done_id" {`)
+	rootMain := readExportFile(t, m.dirPath, defaultTfHCLMainFile)
+	assert.Regexp(t, `source\s+= "./modules/division_sales"`, rootMain)
+	assert.Regexp(t, `genesyscloud_routing_wrapupcode_done_id\s+= module.common.genesyscloud_routing_wrapupcode_done_id`, rootMain)
+	assert.Contains(t, readExportFile(t, m.dirPath, defaultModulesDir, "division_sales", defaultTfHCLVersionFile), "required_providers")
+
+	// Environment specific values are lifted into root variables
+	assert.Contains(t, commonMain, `"${var.division_5b3d5d8a_7c4e_4bd5_9a3e_0c1f2d3e4f50}"`)
+	janeMain := readExportFile(t, m.dirPath, defaultModulesDir, "division_home", defaultTfHCLMainFile)
+	assert.Contains(t, janeMain, `"jane@${var.email_domain_example_com}"`)
+	assert.Contains(t, janeMain, `"${var.genesyscloud_user_jane_addresses_phone_numbers_number}"`)
+	assert.Contains(t, commonMain, `"${var.url_api_example_com}/v1/users/$${input.userId}"`)
+	assert.Regexp(t, `email_domain_example_com\s+= var.email_domain_example_com`, rootMain)
+	assert.Contains(t, readExportFile(t, m.dirPath, defaultTfHCLVariablesFile), `variable "email_domain_example_com" {`)
+	tfVars := readExportFile(t, m.dirPath, defaultTfVarsFile)
+	assert.Contains(t, tfVars, `email_domain_example_com = "example.com"`)
+	assert.Contains(t, tfVars, `url_api_example_com = "https://api.example.com"`)
+	assert.Contains(t, tfVars, `genesyscloud_user_jane_addresses_phone_numbers_number = "+13175550100"`)
+	for _, environment := range []string{"dev", "prod"} {
+		environmentTfVars := readExportFile(t, m.dirPath, defaultEnvironmentsDir, environment+".tfvars")
+		assert.Contains(t, environmentTfVars, `email_domain_example_com = ""`)
+		assert.Contains(t, environmentTfVars, `url_api_example_com = ""`)
+		assert.NotContains(t, environmentTfVars, `= "example.com"`, "the values of the exported org must not be copied")
+	}
+
+	// Import blocks target the resources inside their modules
+	assert.Contains(t, readExportFile(t, m.dirPath, defaultTfHCLImportsFile), "to = module.division_sales.genesyscloud_routing_queue.support")
+}
+
+func TestUnitTfExportModulesByResourceType(t *testing.T) {
+	m := testModuleExporter(t, moduleGroupingResourceType, nil)
+	assert.Nil(t, m.exportModules())
+
+	assert.Contains(t, readExportFile(t, m.dirPath, defaultModulesDir, "routing_queue", defaultTfHCLMainFile), `"${var.genesyscloud_auth_division_sales_id}"`)
+	assert.Contains(t, readExportFile(t, m.dirPath, defaultModulesDir, "auth_division", defaultTfHCLOutputsFile), `output "genesyscloud_auth_division_sales_id"`)
+	assert.Contains(t, readExportFile(t, m.dirPath, defaultModulesDir, "auth_division_home", defaultTfHCLMainFile), `data "genesyscloud_auth_division_home" "home"`)
+
+	_, err := os.Stat(filepath.Join(m.dirPath, defaultTfHCLImportsFile))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestUnitTfExportModulesKeepDecodedAttributes(t *testing.T) {
+	placeholderId := "placeholder-module-unit-test"
+	attributesDecoded[placeholderId] = `jsonencode({"queue" = "${genesyscloud_routing_queue.support.id}"})`
+	defer delete(attributesDecoded, placeholderId)
+
+	m := testModuleExporter(t, moduleGroupingResourceType, nil)
+	m.resourceTypesJSONMaps["genesyscloud_routing_wrapupcode"]["done"]["settings"] = placeholderId
+	m = NewModuleExporter(m.resourceTypesJSONMaps, m.dataSourceTypesMaps, nil, nil, m.exporters, m.providerSource, m.version, m.dirPath, m.groupBy, m.environments)
+	assert.Nil(t, m.exportModules())
+
+	assert.Contains(t, readExportFile(t, m.dirPath, defaultModulesDir, "routing_wrapupcode", defaultTfHCLMainFile), `"${var.genesyscloud_routing_queue_support_id}"`)
+	assert.Equal(t, `jsonencode({"queue" = "${genesyscloud_routing_queue.support.id}"})`, attributesDecoded[placeholderId], "the shared decoded attributes must not be rewritten")
+}
@@ -0,0 +1,581 @@
+package tfexporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+This file contains the sinks an export is published to once all of its files have been written to the export
+directory. The local sink leaves the files where they are, the S3 sink uploads them to an S3-compatible object store
+and the Git sink commits them to a branch of a Git repository.
+*/
+const (
+	defaultS3Region       = "us-east-1"
+	defaultGitBranch      = "main"
+	defaultGitAuthorName  = "Genesys Cloud Exporter"
+	defaultGitAuthorEmail = "exporter@genesyscloud.invalid"
+)
+
+// outputSink publishes the files of an export directory
+type outputSink interface {
+	publish(ctx context.Context, exportDir string) diag.Diagnostics
+}
+
+// newOutputSink returns the sink configured on the export, or the local sink if no remote output is configured
+func newOutputSink(d *schema.ResourceData) outputSink {
+	if s3Output, ok := d.GetOk("s3_output"); ok {
+		return newS3Sink(s3Output.([]interface{})[0].(map[string]interface{}))
+	}
+	if gitOutput, ok := d.GetOk("git_output"); ok {
+		return newGitSink(gitOutput.([]interface{})[0].(map[string]interface{}))
+	}
+	return &localSink{}
+}
+
+// localSink keeps the export in the export directory
+type localSink struct{}
+
+func (l *localSink) publish(_ context.Context, _ string) diag.Diagnostics {
+	return nil
+}
+
+// Files of the export directory that are only used by the next export on the same machine and are never published
+var unpublishedExportFiles = []string{exportManifestFile, exportStateCacheFile}
+
+// listExportFiles returns the paths of the files to publish relative to the export directory, in lexical order
+func listExportFiles(exportDir string) ([]string, error) {
+	var exportFiles []string
+	err := filepath.WalkDir(exportDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(exportDir, filePath)
+		if err != nil {
+			return err
+		}
+		if lists.ItemInSlice(relPath, unpublishedExportFiles) {
+			return nil
+		}
+		exportFiles = append(exportFiles, filepath.ToSlash(relPath))
+		return nil
+	})
+	return exportFiles, err
+}
+
+// s3Sink uploads the export to a bucket of an S3-compatible object store. Objects are signed with AWS Signature
+// Version 4 so that any store implementing the S3 API (AWS, MinIO, Ceph...) can be used.
+type s3Sink struct {
+	endpoint        *url.URL
+	bucket          string
+	prefix          string
+	region          string
+	accessKeyId     string
+	secretAccessKey string
+	sessionToken    string
+	usePathStyle    bool
+	client          *http.Client
+}
+
+func newS3Sink(config map[string]interface{}) *s3Sink {
+	endpoint := config["endpoint"].(string)
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	endpointUrl, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		// The endpoint is validated by the schema
+		log.Printf("Failed to parse S3 endpoint %s: %v", endpoint, err)
+		endpointUrl = &url.URL{Scheme: "https", Host: endpoint}
+	}
+
+	s := &s3Sink{
+		endpoint:        endpointUrl,
+		bucket:          config["bucket"].(string),
+		prefix:          strings.Trim(config["prefix"].(string), "/"),
+		region:          config["region"].(string),
+		accessKeyId:     config["access_key_id"].(string),
+		secretAccessKey: config["secret_access_key"].(string),
+		usePathStyle:    config["use_path_style"].(bool),
+		client:          &http.Client{Timeout: 5 * time.Minute},
+	}
+	if s.region == "" {
+		s.region = defaultS3Region
+	}
+	// Fall back on the standard AWS environment variables so that keys don't have to be stored in the config
+	if s.accessKeyId == "" && s.secretAccessKey == "" {
+		s.accessKeyId = os.Getenv("AWS_ACCESS_KEY_ID")
+		s.secretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		s.sessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+	return s
+}
+
+func (s *s3Sink) publish(ctx context.Context, exportDir string) diag.Diagnostics {
+	exportFiles, err := listExportFiles(exportDir)
+	if err != nil {
+		return diag.Errorf("Failed to list the files of export directory %s: %v", exportDir, err)
+	}
+
+	for _, exportFile := range exportFiles {
+		contents, err := os.ReadFile(filepath.Join(exportDir, filepath.FromSlash(exportFile)))
+		if err != nil {
+			return diag.Errorf("Failed to read export file %s: %v", exportFile, err)
+		}
+		key := path.Join(s.prefix, exportFile)
+		log.Printf("Uploading %s to S3 bucket %s", key, s.bucket)
+		if err := s.putObject(ctx, key, contents); err != nil {
+			return diag.Errorf("Failed to upload %s to S3 bucket %s: %v", key, s.bucket, err)
+		}
+	}
+	return nil
+}
+
+func (s *s3Sink) objectUrl(key string) string {
+	if s.usePathStyle {
+		return fmt.Sprintf("%s://%s%s/%s/%s", s.endpoint.Scheme, s.endpoint.Host, s.endpoint.Path, s.bucket, awsUriEncode(key, false))
+	}
+	return fmt.Sprintf("%s://%s.%s%s/%s", s.endpoint.Scheme, s.bucket, s.endpoint.Host, s.endpoint.Path, awsUriEncode(key, false))
+}
+
+func (s *s3Sink) putObject(ctx context.Context, key string, contents []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectUrl(key), bytes.NewReader(contents))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if s.accessKeyId != "" {
+		signAwsV4(req, sha256Hex(contents), s.accessKeyId, s.secretAccessKey, s.sessionToken, s.region, time.Now().UTC())
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// Headers left out of the signature, the same as the AWS SDKs
+var awsUnsignedHeaders = map[string]bool{"authorization": true, "user-agent": true, "expect": true, "x-amzn-trace-id": true}
+
+// signAwsV4 adds the headers of an AWS Signature Version 4 for the S3 service to req
+func signAwsV4(req *http.Request, payloadHash string, accessKeyId string, secretAccessKey string, sessionToken string, region string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", sessionToken)
+	}
+
+	// Host and all the headers of the request are signed, except the ones proxies may change
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		if lowerName := strings.ToLower(name); !awsUnsignedHeaders[lowerName] {
+			headers[lowerName] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	headerNames := make([]string, 0, len(headers))
+	for name := range headers {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+	var canonicalHeaders strings.Builder
+	for _, name := range headerNames {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	query := req.URL.Query()
+	queryKeys := make([]string, 0, len(query))
+	for key := range query {
+		queryKeys = append(queryKeys, key)
+	}
+	sort.Strings(queryKeys)
+	canonicalQuery := make([]string, 0, len(queryKeys))
+	for _, key := range queryKeys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			canonicalQuery = append(canonicalQuery, awsUriEncode(key, true)+"="+awsUriEncode(value, true))
+		}
+	}
+
+	canonicalUri := awsUriEncode(req.URL.Path, false)
+	if canonicalUri == "" {
+		canonicalUri = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalUri,
+		strings.Join(canonicalQuery, "&"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSha256([]byte("AWS4"+secretAccessKey), date)
+	signingKey = hmacSha256(signingKey, region)
+	signingKey = hmacSha256(signingKey, "s3")
+	signingKey = hmacSha256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSha256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s,SignedHeaders=%s,Signature=%s", accessKeyId, scope, signedHeaders, signature))
+}
+
+// awsUriEncode percent-encodes every byte except the unreserved characters, as required by Signature Version 4
+func awsUriEncode(value string, encodeSlash bool) string {
+	var encoded strings.Builder
+	for _, b := range []byte(value) {
+		if (b >= 'A' && b <= 'Z') || (b >= 'a' && b <= 'z') || (b >= '0' && b <= '9') || b == '-' || b == '_' || b == '.' || b == '~' || (b == '/' && !encodeSlash) {
+			encoded.WriteByte(b)
+		} else {
+			encoded.WriteString(fmt.Sprintf("%%%02X", b))
+		}
+	}
+	return encoded.String()
+}
+
+func hmacSha256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// gitSink commits the export to a branch of a Git repository and pushes it. The branch is created if it doesn't
+// exist yet. The commit message summarizes the resources added, changed and removed since the previous export on the
+// branch.
+type gitSink struct {
+	repository  string
+	branch      string
+	authorName  string
+	authorEmail string
+}
+
+func newGitSink(config map[string]interface{}) *gitSink {
+	g := &gitSink{
+		repository:  config["repository"].(string),
+		branch:      config["branch"].(string),
+		authorName:  config["author_name"].(string),
+		authorEmail: config["author_email"].(string),
+	}
+	if g.branch == "" {
+		g.branch = defaultGitBranch
+	}
+	if g.authorName == "" {
+		g.authorName = defaultGitAuthorName
+	}
+	if g.authorEmail == "" {
+		g.authorEmail = defaultGitAuthorEmail
+	}
+	// Git commands run in a temporary work tree, so relative paths must be resolved against the working directory
+	if isLocalGitRepository(g.repository) {
+		if absPath, err := filepath.Abs(g.repository); err == nil {
+			g.repository = absPath
+		} else {
+			log.Printf("Failed to get the absolute path of repository %s: %v", g.repository, err)
+		}
+	}
+	return g
+}
+
+// isLocalGitRepository is false for URLs such as 'https://host/repo.git' and scp-like addresses such as
+// 'git@host:repo.git', the same way Git tells them apart from local paths
+func isLocalGitRepository(repository string) bool {
+	if strings.Contains(repository, "://") {
+		return false
+	}
+	if filepath.VolumeName(repository) != "" {
+		return true
+	}
+	colon := strings.Index(repository, ":")
+	slash := strings.Index(repository, "/")
+	return colon < 0 || (slash >= 0 && slash < colon)
+}
+
+func (g *gitSink) publish(ctx context.Context, exportDir string) diag.Diagnostics {
+	workTree, err := os.MkdirTemp("", "genesyscloud_tf_export_git")
+	if err != nil {
+		return diag.Errorf("Failed to create Git work tree: %v", err)
+	}
+	defer os.RemoveAll(workTree)
+
+	if _, err := g.git(ctx, workTree, "init", "--quiet"); err != nil {
+		return diag.Errorf("Failed to initialize Git work tree: %v", err)
+	}
+	// A branch that can't be fetched doesn't exist yet and is created without history
+	if _, err := g.git(ctx, workTree, "fetch", "--quiet", "--depth=1", g.repository, "refs/heads/"+g.branch); err == nil {
+		if _, err := g.git(ctx, workTree, "checkout", "--quiet", "-B", g.branch, "FETCH_HEAD"); err != nil {
+			return diag.Errorf("Failed to check out branch %s of %s: %v", g.branch, g.repository, err)
+		}
+	} else {
+		log.Printf("Branch %s not found in %s, it will be created: %v", g.branch, g.repository, err)
+		if _, err := g.git(ctx, workTree, "checkout", "--quiet", "--orphan", g.branch); err != nil {
+			return diag.Errorf("Failed to create branch %s: %v", g.branch, err)
+		}
+	}
+
+	previousResources, err := readExportedResources(workTree)
+	if err != nil {
+		return diag.Errorf("Failed to read the resources of the previous export on branch %s: %v", g.branch, err)
+	}
+	exportedResources, err := readExportedResources(exportDir)
+	if err != nil {
+		return diag.Errorf("Failed to read the resources of the export: %v", err)
+	}
+
+	if err := replaceWorkTree(workTree, exportDir); err != nil {
+		return diag.Errorf("Failed to copy the export to the Git work tree: %v", err)
+	}
+
+	if _, err := g.git(ctx, workTree, "add", "--all"); err != nil {
+		return diag.Errorf("Failed to stage the export: %v", err)
+	}
+	status, err := g.git(ctx, workTree, "status", "--porcelain")
+	if err != nil {
+		return diag.Errorf("Failed to get the status of the Git work tree: %v", err)
+	}
+	if strings.TrimSpace(status) == "" {
+		log.Printf("Export is unchanged, nothing to commit to %s", g.repository)
+		return nil
+	}
+
+	message := exportCommitMessage(previousResources, exportedResources)
+	if _, err := g.git(ctx, workTree, "commit", "--quiet", "--no-verify", "-m", message); err != nil {
+		return diag.Errorf("Failed to commit the export: %v", err)
+	}
+	log.Printf("Pushing export to branch %s of %s", g.branch, g.repository)
+	if _, err := g.git(ctx, workTree, "push", "--quiet", g.repository, "HEAD:refs/heads/"+g.branch); err != nil {
+		return diag.Errorf("Failed to push the export to branch %s of %s: %v", g.branch, g.repository, err)
+	}
+	return nil
+}
+
+// git runs a git command in dir with the configured author, independent of the user's global config
+func (g *gitSink) git(ctx context.Context, dir string, args ...string) (string, error) {
+	command := args[0]
+	args = append([]string{
+		"-c", "user.name=" + g.authorName,
+		"-c", "user.email=" + g.authorEmail,
+		"-c", "commit.gpgsign=false",
+	}, args...)
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return stdout.String(), fmt.Errorf("git %s: %v: %s", command, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+// replaceWorkTree replaces everything but the .git directory of workTree with the files of exportDir, so that
+// files which are no longer exported are removed from the branch
+func replaceWorkTree(workTree string, exportDir string) error {
+	entries, err := os.ReadDir(workTree)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.Name() == ".git" {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(workTree, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	exportFiles, err := listExportFiles(exportDir)
+	if err != nil {
+		return err
+	}
+	for _, exportFile := range exportFiles {
+		contents, err := os.ReadFile(filepath.Join(exportDir, filepath.FromSlash(exportFile)))
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(workTree, filepath.FromSlash(exportFile))
+		if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(target, contents, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readExportedResources returns the blocks of the resources and data sources exported to dir by their address, e.g.
+// 'genesyscloud_user.jane' or 'module.routing.genesyscloud_routing_queue.support'. Both HCL and JSON configurations
+// are read, other files are ignored.
+func readExportedResources(dir string) (map[string]string, error) {
+	resources := make(map[string]string)
+	err := filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// Resources of modules are addressed through the module of the same name
+		var addressPrefix string
+		if relPath, err := filepath.Rel(dir, filepath.Dir(filePath)); err == nil && relPath != "." {
+			addressPrefix = "module." + filepath.Base(relPath) + "."
+		}
+
+		switch {
+		case strings.HasSuffix(filePath, ".tf.json"):
+			return readJSONResources(filePath, addressPrefix, resources)
+		case strings.HasSuffix(filePath, ".tf"):
+			return readHCLResources(filePath, addressPrefix, resources)
+		}
+		return nil
+	})
+	return resources, err
+}
+
+func readHCLResources(filePath string, addressPrefix string, resources map[string]string) error {
+	src, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	file, diags := hclsyntax.ParseConfig(src, filePath, hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		if address := exportedResourceAddress(block.Type, block.Labels); address != "" {
+			blockRange := block.Range()
+			resources[addressPrefix+address] = string(src[blockRange.Start.Byte:blockRange.End.Byte])
+		}
+	}
+	return nil
+}
+
+func readJSONResources(filePath string, addressPrefix string, resources map[string]string) error {
+	src, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	var blocks map[string]json.RawMessage
+	if err := json.Unmarshal(src, &blocks); err != nil {
+		return fmt.Errorf("failed to parse %s: %v", filePath, err)
+	}
+	// Only the resource and data blocks are decoded, other blocks have a different shape
+	for _, blockType := range []string{"resource", "data"} {
+		if blocks[blockType] == nil {
+			continue
+		}
+		var typeBlocks map[string]map[string]json.RawMessage
+		if err := json.Unmarshal(blocks[blockType], &typeBlocks); err != nil {
+			return fmt.Errorf("failed to parse the %s blocks of %s: %v", blockType, filePath, err)
+		}
+		for resType, namedBlocks := range typeBlocks {
+			for name, body := range namedBlocks {
+				resources[addressPrefix+exportedResourceAddress(blockType, []string{resType, name})] = string(body)
+			}
+		}
+	}
+	return nil
+}
+
+// exportedResourceAddress returns the address of a resource or data block, or an empty string for other blocks
+func exportedResourceAddress(blockType string, labels []string) string {
+	if len(labels) != 2 {
+		return ""
+	}
+	switch blockType {
+	case "resource":
+		return labels[0] + "." + labels[1]
+	case "data":
+		return "data." + labels[0] + "." + labels[1]
+	}
+	return ""
+}
+
+// exportCommitMessage summarizes the resources added, changed and removed by an export from the resources of the
+// previous and the new export, by address
+func exportCommitMessage(previousResources map[string]string, exportedResources map[string]string) string {
+	var added, changed, removed []string
+	for address, block := range exportedResources {
+		previousBlock, ok := previousResources[address]
+		if !ok {
+			added = append(added, address)
+		} else if previousBlock != block {
+			changed = append(changed, address)
+		}
+	}
+	for address := range previousResources {
+		if _, ok := exportedResources[address]; !ok {
+			removed = append(removed, address)
+		}
+	}
+
+	var message strings.Builder
+	message.WriteString(fmt.Sprintf("Genesys Cloud export: %d added, %d changed, %d removed\n", len(added), len(changed), len(removed)))
+	for _, section := range []struct {
+		title     string
+		addresses []string
+	}{{"Added", added}, {"Changed", changed}, {"Removed", removed}} {
+		if len(section.addresses) == 0 {
+			continue
+		}
+		sort.Strings(section.addresses)
+		message.WriteString("\n" + section.title + ":\n")
+		for _, address := range section.addresses {
+			message.WriteString("  " + address + "\n")
+		}
+	}
+	return message.String()
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type fileMeta struct {
@@ -37,6 +38,7 @@ func ResourceTfExport() *schema.Resource {
 		CreateWithoutTimeout: createTfExport,
 		ReadWithoutTimeout:   readTfExport,
 		DeleteContext:        deleteTfExport,
+		CustomizeDiff:        validateModularizeBy,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -98,6 +100,28 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"include_import_blocks": {
+				Description: fmt.Sprintf("Export a '%s' file (or '%s' when exporting JSON) with a Terraform 1.5+ `import` block for every exported resource, so that existing resources can be adopted with `terraform plan`. When the export is split by resource, each resource type gets its own imports file. As with `include_state_file`, GUID fields that cannot be resolved to a reference are kept in the config.", defaultTfHCLImportsFile, defaultTfJSONImportsFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"modularize_by": {
+				Description:   fmt.Sprintf("Export the config as HCL modules, one per resource type ('%s') or per division ('%s'). The root module in '%s' wires the modules together through module outputs. Division IDs, phone numbers, email domains and integration action URLs are lifted into root variables so the modules can be promoted between orgs. Requires `export_as_hcl` and cannot be used with `include_state_file` or `split_files_by_resource`.", moduleGroupingResourceType, moduleGroupingDivision, defaultTfHCLMainFile),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringInSlice([]string{moduleGroupingResourceType, moduleGroupingDivision}, false),
+				ConflictsWith: []string{"include_state_file"},
+			},
+			"module_environments": {
+				Description: fmt.Sprintf("Environments to write a tfvars file for when `modularize_by` is set, e.g. 'dev', 'test' and 'prod'. The values of the exported org are written to '%s'. Each environment gets a '%s/<environment>.tfvars' file listing the variables lifted from the exported org with empty values, to be filled in with the values of that org.", defaultTfVarsFile, defaultEnvironmentsDir),
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+			},
 			"export_as_hcl": {
 				Description: "Export the config as HCL.",
 				Type:        schema.TypeBool,
@@ -140,6 +164,33 @@ func ResourceTfExport() *schema.Resource {
 				Default:     true,
 				ForceNew:    true,
 			},
+			"incremental": {
+				Description: fmt.Sprintf("Reuse the state of resources that haven't changed since the previous export into the same directory instead of reading them again. Changes are detected with the '%s' manifest written next to incremental exports, which is kept when this resource is replaced along with the states cached in '%s'. Only routing skills, wrapup codes, groups, flows, IVRs, schedules, schedule groups and emergency groups report a version when listed, resources of other types are always read. Users and queues in particular are always read, as their skills, members and wrapup codes change without changing their version.", exportManifestFile, exportStateCacheFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"drift_state_file": {
+				Description: fmt.Sprintf("Path to an existing Terraform state file to compare the org against. When set, '%s' and '%s' are written to the export directory, listing resources that are unmanaged, deleted in the org, or whose attributes differ from the state. Attributes are compared after the same sanitization as the exported config.", defaultDriftReportMarkdownFile, defaultDriftReportJSONFile),
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"dependency_graph_format": {
+				Description:  fmt.Sprintf("Write the graph of the references between the exported resources to '%s' in the export directory, in the Graphviz DOT ('%s', written to '.dot'), Mermaid ('%s', written to '.mmd') or JSON ('%s', written to '.json') format. Each edge lists the attributes holding the reference, and the references between flows listed in 'cyclicDepends.txt' are marked as cyclic.", defaultDependencyGraphFile, dependencyGraphDot, dependencyGraphMermaid, dependencyGraphJson),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{dependencyGraphDot, dependencyGraphMermaid, dependencyGraphJson}, false),
+			},
+			"validate_config": {
+				Description: fmt.Sprintf("Validate the exported config against the schemas of the provider, as during a plan: missing required attributes, attribute types and values, unknown attributes, and references to resources, data sources, variables or modules that are not declared. The problems are written to '%s' in the export directory and returned as a warning. Values that are only known during a plan, such as references, are not validated.", defaultValidationReportFile),
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 			"compress": {
 				Description: "Compress exported results using zip format",
 				Type:        schema.TypeBool,
@@ -147,6 +198,146 @@ func ResourceTfExport() *schema.Resource {
 				Default:     false,
 				ForceNew:    true,
 			},
+			"resource_naming": {
+				Description: "How the exported resources are named. Without this block, names are sanitized by the optimized sanitizer, or by the original one when the `GENESYS_SANITIZER_LEGACY` environment variable is set.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"strategy": {
+							Description:  fmt.Sprintf("Naming strategy. '%s' sanitizes the names and appends a hash of the name to names that collide, so the name of a resource changes when another resource with the same name is added. '%s' is the original sanitizer, which appends a hash of the name to every name that had to be sanitized. '%s' appends a hash of the resource ID to every name. '%s' prefixes the names of resources that have a `division_id` with the name of their division, and appends a hash of the resource ID to names that still collide. Defaults to the sanitizer used without this block.", resourceExporter.SanitizerStrategyOptimized, resourceExporter.SanitizerStrategyLegacy, resourceExporter.SanitizerStrategyIdSuffix, resourceExporter.SanitizerStrategyDivisionPrefix),
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{resourceExporter.SanitizerStrategyOptimized, resourceExporter.SanitizerStrategyLegacy, resourceExporter.SanitizerStrategyIdSuffix, resourceExporter.SanitizerStrategyDivisionPrefix}, false),
+						},
+						"map_file": {
+							Description: "Path of a JSON file mapping the ID of every exported resource to its name, by resource type. Resources in the file keep their name in every export whatever the strategy, and other resources never get one of these names. The file is created by the first export and the resources of each export are added to it, so it should be kept with the exported config, e.g. in the same Git repository. A map file in `directory` is kept when the export is replaced.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"resource_cache": {
+				Description: "Keep the objects read from Genesys Cloud in files that the next exports reuse instead of reading the objects again until they expire, e.g. to speed up repeated exports during development. Changes made to the org in the meantime are not exported until the cached objects expire. Objects of resource types that were read before the export started are not cached.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory": {
+							Description:  "Directory of the cache files. The objects of each org are kept in a sub directory named after the org ID.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"ttl": {
+							Description:  "How long the cached objects are reused, e.g. '30m' or '12h'.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      defaultResourceCacheTtl,
+							ValidateFunc: validateDuration,
+						},
+						"invalidate": {
+							Description: "Delete the cached objects of the org before exporting, so that every object is read again.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"s3_output": {
+				Description:   fmt.Sprintf("Upload the export to a bucket of an S3-compatible object store (e.g. AWS S3 or MinIO) once it has been written to `directory`. Existing objects with the same keys are overwritten, objects that are no longer exported are not deleted. The '%s' manifest and '%s' state cache of incremental exports are not uploaded.", exportManifestFile, exportStateCacheFile),
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ForceNew:      true,
+				ConflictsWith: []string{"git_output"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Description:  "URL of the object store, e.g. 'https://s3.us-east-1.amazonaws.com' or 'http://localhost:9000'. HTTPS is used if no scheme is given.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"bucket": {
+							Description:  "Bucket to upload the export to.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"prefix": {
+							Description: "Key prefix of the uploaded files, e.g. 'exports/prod'. Defaults to the root of the bucket.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"region": {
+							Description: "Region used to sign the requests.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     defaultS3Region,
+						},
+						"access_key_id": {
+							Description: "Access key ID. If neither key is set, they are read from the `AWS_ACCESS_KEY_ID`, `AWS_SECRET_ACCESS_KEY` and `AWS_SESSION_TOKEN` environment variables. Requests are not signed if no key is found.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"secret_access_key": {
+							Description: "Secret access key.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"use_path_style": {
+							Description: "Address the bucket in the URL path (`<endpoint>/<bucket>/<key>`) rather than as a subdomain of the endpoint. Required by MinIO and most self-hosted stores.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+			"git_output": {
+				Description:   fmt.Sprintf("Commit the export to a branch of a Git repository and push it once it has been written to `directory`. The branch is replaced with the contents of the export, and the commit message lists the addresses of the resources added, changed and removed since the previous export on the branch. The '%s' manifest and '%s' state cache of incremental exports are not committed. Nothing is committed if the export is unchanged. Requires the `git` CLI.", exportManifestFile, exportStateCacheFile),
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ForceNew:      true,
+				ConflictsWith: []string{"s3_output"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository": {
+							Description:  "Path or URL of the repository to push to, e.g. '/srv/git/exports.git' or 'file:///srv/git/exports.git'. Relative paths are resolved against the working directory of Terraform. Pushing to the checked out branch of a non-bare repository is rejected by Git.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"branch": {
+							Description: "Branch to commit the export to. It is created if it doesn't exist.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     defaultGitBranch,
+						},
+						"author_name": {
+							Description: "Name of the commit author.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     defaultGitAuthorName,
+						},
+						"author_email": {
+							Description: "Email of the commit author.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     defaultGitAuthorEmail,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -157,35 +348,51 @@ func createTfExport(ctx context.Context, d *schema.ResourceData, meta interface{
 	if _, ok := d.GetOk("include_filter_resources"); ok {
 		gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, IncludeResources)
 		diagErr := gre.Export()
-		if diagErr != nil {
+		if diagErr.HasError() {
 			return diagErr
 		}
 
 		d.SetId(gre.exportDirPath)
-		return nil
+		return diagErr
 	}
 
 	if _, ok := d.GetOk("exclude_filter_resources"); ok {
 		gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, ExcludeResources)
 		diagErr := gre.Export()
-		if diagErr != nil {
+		if diagErr.HasError() {
 			return diagErr
 		}
 
 		d.SetId(gre.exportDirPath)
-		return nil
+		return diagErr
 	}
 
 	//Dealing with the traditional resource
 	gre, _ := NewGenesysCloudResourceExporter(ctx, d, meta, LegacyInclude)
 	diagErr := gre.Export()
 
-	if diagErr != nil {
+	if diagErr.HasError() {
 		return diagErr
 	}
 
 	d.SetId(gre.exportDirPath)
 
+	// Problems found in the exported config are returned as warnings
+	return diagErr
+}
+
+// validateModularizeBy rejects the options the module export doesn't support. Modules are always written as HCL
+// with one main.tf per module.
+func validateModularizeBy(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("modularize_by").(string) == "" {
+		return nil
+	}
+	if !diff.Get("export_as_hcl").(bool) {
+		return fmt.Errorf("modularize_by requires export_as_hcl to be true")
+	}
+	if diff.Get("split_files_by_resource").(bool) {
+		return fmt.Errorf("modularize_by cannot be used with split_files_by_resource")
+	}
 	return nil
 }
 
@@ -212,8 +419,17 @@ func deleteTfExport(_ context.Context, d *schema.ResourceData, _ interface{}) di
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	for _, d := range dir {
-		os.RemoveAll(filepath.Join(exportPath, d.Name()))
+	// Keep the manifest so that the export replacing this one can be incremental
+	keepManifest := d.Get("incremental").(bool)
+	for _, entry := range dir {
+		if keepManifest && (entry.Name() == exportManifestFile || entry.Name() == exportStateCacheFile) {
+			continue
+		}
+		// The names pinned in the map file are needed by the export replacing this one
+		if isResourceNameMapFile(d.Get("resource_naming").([]interface{}), filepath.Join(exportPath, entry.Name())) {
+			continue
+		}
+		os.RemoveAll(filepath.Join(exportPath, entry.Name()))
 	}
 
 	return nil
@@ -0,0 +1,244 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+/*
+This file contains the naming of the exported resources. The resource_naming block selects the strategy used to name
+the resources, and a map file pins the names of the resources exported before, so that they keep their name in every
+export whatever resources are added to the org in the meantime.
+*/
+
+// getDivisionNames returns the names of the divisions of the org by ID, for the division_prefix strategy
+var getDivisionNames = func(clientConfig *platformclientv2.Configuration) (map[string]string, *platformclientv2.APIResponse, error) {
+	authAPI := platformclientv2.NewAuthorizationApiWithConfig(clientConfig)
+	names := make(map[string]string)
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		divisions, resp, err := authAPI.GetAuthorizationDivisions(pageSize, pageNum, "", nil, "", "", false, nil, "")
+		if err != nil {
+			return nil, resp, err
+		}
+		if divisions.Entities == nil || len(*divisions.Entities) == 0 {
+			return names, resp, nil
+		}
+		for _, division := range *divisions.Entities {
+			names[*division.Id] = *division.Name
+		}
+	}
+}
+
+type resourceNaming struct {
+	strategy string
+	mapFile  string
+
+	// Map of resource type to resource ID to pinned name
+	pinned map[string]map[string]string
+
+	divisionsOnce sync.Once
+	divisions     map[string]string
+	divisionsErr  diag.Diagnostics
+}
+
+// setupResourceNaming reads the naming strategy and loads the names pinned in the map file
+func (g *GenesysCloudResourceExporter) setupResourceNaming() diag.Diagnostics {
+	g.resourceNaming = &resourceNaming{pinned: make(map[string]map[string]string)}
+	namingConfig := g.d.Get("resource_naming").([]interface{})
+	if len(namingConfig) == 0 || namingConfig[0] == nil {
+		return nil
+	}
+	settings := namingConfig[0].(map[string]interface{})
+	g.resourceNaming.strategy = settings["strategy"].(string)
+	g.resourceNaming.mapFile = settings["map_file"].(string)
+
+	if _, err := resourceExporter.NewSanitizerProviderByStrategy(g.resourceNaming.strategy); err != nil {
+		return diag.FromErr(err)
+	}
+	if g.resourceNaming.mapFile == "" {
+		return nil
+	}
+	return g.resourceNaming.loadMapFile()
+}
+
+// loadMapFile loads the names pinned by the previous exports. The file doesn't exist before the first export.
+func (n *resourceNaming) loadMapFile() diag.Diagnostics {
+	contents, err := os.ReadFile(n.mapFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Printf("Resource name map %s doesn't exist yet, it will be created", n.mapFile)
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("Failed to read resource name map %s: %v", n.mapFile, err)
+	}
+	if err := json.Unmarshal(contents, &n.pinned); err != nil {
+		return diag.Errorf("Failed to parse resource name map %s: %v", n.mapFile, err)
+	}
+
+	sanitizer := resourceExporter.NewSanitizerProvider()
+	for resType, names := range n.pinned {
+		ids := make(map[string]string)
+		for id, name := range names {
+			// Names edited by hand must still be valid
+			if name == "" {
+				return diag.Errorf("Resource name map %s has an empty name for %s %s", n.mapFile, resType, id)
+			}
+			name = sanitizer.S.SanitizeResourceName(name)
+			if otherId, ok := ids[name]; ok {
+				return diag.Errorf("Resource name map %s pins %s %s to the name of %s", n.mapFile, resType, id, otherId)
+			}
+			ids[name] = id
+			names[id] = name
+		}
+	}
+	log.Printf("Loaded pinned resource names from %s", n.mapFile)
+	return nil
+}
+
+// sanitizer returns the Sanitizer of the resource names of a type
+func (n *resourceNaming) sanitizer(resType string) resourceExporter.Sanitizer {
+	if n == nil {
+		return nil
+	}
+	sanitizer, _ := resourceExporter.NewSanitizerProviderByStrategy(n.strategy)
+	// With division prefixes the names are pinned once the divisions are known
+	if len(n.pinned[resType]) == 0 || n.strategy == resourceExporter.SanitizerStrategyDivisionPrefix {
+		return sanitizer.S
+	}
+	return resourceExporter.NewPinnedSanitizer(sanitizer.S, n.pinned[resType])
+}
+
+// prefixDivisionNames prefixes the names of the resources of a type with the name of their division when the
+// division_prefix strategy is used. The names of the resources and of the SanitizedResourceMap of their exporter are
+// both updated, so that references to the resources use the new names.
+func (g *GenesysCloudResourceExporter) prefixDivisionNames(resType string, exporter *resourceExporter.ResourceExporter, resources []resourceExporter.ResourceInfo) diag.Diagnostics {
+	n := g.resourceNaming
+	if n == nil || n.strategy != resourceExporter.SanitizerStrategyDivisionPrefix || len(resources) == 0 {
+		return nil
+	}
+
+	n.divisionsOnce.Do(func() {
+		divisions, resp, err := getDivisionNames(g.meta.(*provider.ProviderMeta).ClientConfig)
+		if err != nil {
+			n.divisionsErr = diag.Errorf("Failed to get the divisions to prefix the resource names with: %v %v", err, resp)
+			return
+		}
+		n.divisions = divisions
+	})
+	if n.divisionsErr != nil {
+		return n.divisionsErr
+	}
+
+	// Resources are keyed by their import ID, the SanitizedResourceMap by the ID returned when listing them
+	ids := make(map[string]string)
+	for id, meta := range exporter.SanitizedResourceMap {
+		ids[meta.IdPrefix+id] = id
+	}
+
+	sanitizer := resourceExporter.NewSanitizerProvider()
+	reserved := make(map[string]bool)
+	for _, name := range n.pinned[resType] {
+		reserved[name] = true
+	}
+	names := make(resourceExporter.ResourceIDMetaMap)
+	for _, resource := range resources {
+		id, ok := ids[resource.ImportId]
+		if !ok {
+			continue
+		}
+		name := exporter.SanitizedResourceMap[id].Name
+		if pinnedName, ok := n.pinned[resType][id]; ok {
+			names[id] = &resourceExporter.ResourceMeta{Name: pinnedName}
+			continue
+		}
+		// Resources replaced with a data source are matched by name, so they keep it
+		if g.isDataSource(resType, name) {
+			reserved[name] = true
+			continue
+		}
+		if divisionName, ok := n.divisions[resource.State.Attributes["division_id"]]; ok {
+			if prefixedName := sanitizer.S.SanitizeResourceName(divisionName) + "_" + name; !g.isDataSource(resType, prefixedName) {
+				name = prefixedName
+			}
+		}
+		names[id] = &resourceExporter.ResourceMeta{Name: name}
+	}
+
+	unpinned := make(resourceExporter.ResourceIDMetaMap)
+	for id, meta := range names {
+		if _, ok := n.pinned[resType][id]; !ok {
+			unpinned[id] = meta
+		}
+	}
+	resourceExporter.ResolveResourceNameCollisions(unpinned, reserved)
+
+	for i := range resources {
+		if id, ok := ids[resources[i].ImportId]; ok && names[id] != nil {
+			resources[i].Name = names[id].Name
+			exporter.SanitizedResourceMap[id].Name = names[id].Name
+		}
+	}
+	return nil
+}
+
+// writeResourceNameMap adds the names of the exported resources to the map file, so that the next exports keep them
+func (g *GenesysCloudResourceExporter) writeResourceNameMap() diag.Diagnostics {
+	n := g.resourceNaming
+	if n == nil || n.mapFile == "" {
+		return nil
+	}
+
+	// Names of resources that are not part of this export stay pinned
+	for resType, exporter := range *g.exporters {
+		for id, meta := range exporter.SanitizedResourceMap {
+			if meta.Name == "" {
+				continue
+			}
+			if n.pinned[resType] == nil {
+				n.pinned[resType] = make(map[string]string)
+			}
+			n.pinned[resType][id] = meta.Name
+		}
+	}
+
+	data, err := json.MarshalIndent(n.pinned, "", "  ")
+	if err != nil {
+		return diag.Errorf("Failed to encode resource name map as JSON: %v", err)
+	}
+	if dir := filepath.Dir(n.mapFile); dir != "" {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return diag.Errorf("Failed to create the directory of resource name map %s: %v", n.mapFile, err)
+		}
+	}
+	log.Printf("Writing resource name map %s", n.mapFile)
+	return files.WriteToFile(data, n.mapFile)
+}
+
+// isResourceNameMapFile reports whether path is the map file of an export, which is kept when the export is deleted
+func isResourceNameMapFile(namingConfig []interface{}, path string) bool {
+	if len(namingConfig) == 0 || namingConfig[0] == nil {
+		return false
+	}
+	mapFile := namingConfig[0].(map[string]interface{})["map_file"].(string)
+	if mapFile == "" {
+		return false
+	}
+	mapFilePath, err := filepath.Abs(mapFile)
+	if err != nil {
+		return false
+	}
+	absPath, err := filepath.Abs(path)
+	return err == nil && absPath == mapFilePath
+}
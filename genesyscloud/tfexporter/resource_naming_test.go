@@ -0,0 +1,107 @@
+package tfexporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitTfExportPrefixDivisionNames(t *testing.T) {
+	originalGetDivisionNames := getDivisionNames
+	defer func() { getDivisionNames = originalGetDivisionNames }()
+	getDivisionNames = func(*platformclientv2.Configuration) (map[string]string, *platformclientv2.APIResponse, error) {
+		return map[string]string{"div-1": "North America", "div-2": "Europe"}, nil, nil
+	}
+
+	exporter := &resourceExporter.ResourceExporter{SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{
+		"queue-1": {Name: "Sales_Queue"},
+		"queue-2": {Name: "Sales_Queue"},
+		"queue-3": {Name: "Sales_Queue"},
+		"queue-4": {Name: "Support"},
+		"queue-5": {Name: "Billing"},
+	}}
+	resources := []resourceExporter.ResourceInfo{
+		{Type: "genesyscloud_routing_queue", Name: "Sales_Queue", ImportId: "queue-1", State: &terraform.InstanceState{ID: "queue-1", Attributes: map[string]string{"division_id": "div-1"}}},
+		{Type: "genesyscloud_routing_queue", Name: "Sales_Queue", ImportId: "queue-2", State: &terraform.InstanceState{ID: "queue-2", Attributes: map[string]string{"division_id": "div-2"}}},
+		{Type: "genesyscloud_routing_queue", Name: "Sales_Queue", ImportId: "queue-3", State: &terraform.InstanceState{ID: "queue-3", Attributes: map[string]string{"division_id": "div-2"}}},
+		{Type: "genesyscloud_routing_queue", Name: "Support", ImportId: "queue-4", State: &terraform.InstanceState{ID: "queue-4", Attributes: map[string]string{}}},
+		{Type: "genesyscloud_routing_queue", Name: "Billing", ImportId: "queue-5", State: &terraform.InstanceState{ID: "queue-5", Attributes: map[string]string{"division_id": "div-1"}}},
+	}
+
+	gre := &GenesysCloudResourceExporter{
+		meta: &provider.ProviderMeta{},
+		resourceNaming: &resourceNaming{
+			strategy: resourceExporter.SanitizerStrategyDivisionPrefix,
+			pinned:   map[string]map[string]string{"genesyscloud_routing_queue": {"queue-5": "billing_queue"}},
+		},
+	}
+	assert.Nil(t, gre.prefixDivisionNames("genesyscloud_routing_queue", exporter, resources))
+
+	expected := map[string]string{
+		"queue-1": "North_America_Sales_Queue",
+		"queue-2": "Europe_Sales_Queue",
+		"queue-3": resourceExporter.AppendIdSuffix("Europe_Sales_Queue", "queue-3"),
+		"queue-4": "Support",
+		"queue-5": "billing_queue",
+	}
+	for _, resource := range resources {
+		assert.Equal(t, expected[resource.ImportId], resource.Name)
+		assert.Equal(t, expected[resource.ImportId], exporter.SanitizedResourceMap[resource.ImportId].Name)
+	}
+}
+
+func TestUnitTfExportResourceNameMapFile(t *testing.T) {
+	mapFile := filepath.Join(t.TempDir(), "names", "resource_names.json")
+	d := schema.TestResourceDataRaw(t, ResourceTfExport().Schema, map[string]interface{}{
+		"resource_naming": []interface{}{map[string]interface{}{
+			"strategy": resourceExporter.SanitizerStrategyIdSuffix,
+			"map_file": mapFile,
+		}},
+	})
+
+	// The first export creates the map file
+	gre := &GenesysCloudResourceExporter{d: d}
+	assert.Nil(t, gre.setupResourceNaming())
+	assert.Empty(t, gre.resourceNaming.pinned)
+	gre.exporters = &map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_routing_queue": {SanitizedResourceMap: resourceExporter.ResourceIDMetaMap{"queue-1": {Name: "Sales_Queue_1a2b3c4d"}}},
+	}
+	assert.Nil(t, gre.writeResourceNameMap())
+
+	// The next export keeps the names and adds the new resources
+	gre = &GenesysCloudResourceExporter{d: d}
+	assert.Nil(t, gre.setupResourceNaming())
+	assert.Equal(t, map[string]map[string]string{"genesyscloud_routing_queue": {"queue-1": "Sales_Queue_1a2b3c4d"}}, gre.resourceNaming.pinned)
+
+	metaMap := resourceExporter.ResourceIDMetaMap{"queue-1": {Name: "Sales Queue"}, "queue-2": {Name: "Support"}}
+	gre.resourceNaming.sanitizer("genesyscloud_routing_queue").Sanitize(metaMap)
+	assert.Equal(t, "Sales_Queue_1a2b3c4d", metaMap["queue-1"].Name)
+	assert.Equal(t, resourceExporter.AppendIdSuffix("Support", "queue-2"), metaMap["queue-2"].Name)
+
+	gre.exporters = &map[string]*resourceExporter.ResourceExporter{
+		"genesyscloud_routing_queue": {SanitizedResourceMap: metaMap},
+	}
+	assert.Nil(t, gre.writeResourceNameMap())
+	contents, err := os.ReadFile(mapFile)
+	assert.Nil(t, err)
+	var pinned map[string]map[string]string
+	assert.Nil(t, json.Unmarshal(contents, &pinned))
+	assert.Equal(t, map[string]map[string]string{"genesyscloud_routing_queue": {
+		"queue-1": "Sales_Queue_1a2b3c4d",
+		"queue-2": resourceExporter.AppendIdSuffix("Support", "queue-2"),
+	}}, pinned)
+
+	// Two resources cannot be pinned to the same name
+	assert.Nil(t, os.WriteFile(mapFile, []byte(`{"genesyscloud_routing_queue": {"queue-1": "Sales Queue", "queue-2": "Sales_Queue"}}`), 0644))
+	gre = &GenesysCloudResourceExporter{d: d}
+	assert.NotNil(t, gre.setupResourceNaming())
+}
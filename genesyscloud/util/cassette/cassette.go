@@ -0,0 +1,400 @@
+/*
+Package cassette records the HTTP traffic between the provider and Genesys Cloud into cassette files and replays
+it, so that acceptance tests can run without network access or credentials.
+
+The mode is selected with the GENESYSCLOUD_HTTP_CASSETTE environment variable:
+
+  - record: requests are forwarded to Genesys Cloud and every request/response pair is written to
+    test/data/cassettes/<TestName>.json when the test finishes. Credentials and correlation ids are scrubbed.
+  - replay: requests are answered from the cassette of the running test. No request leaves the process. Set TF_UNIT
+    as well so that provider.AuthorizeSdk doesn't log in to Genesys Cloud. Tests without a cassette are skipped.
+
+Every test gets its own cassette and local server from Use. The acceptance tests get their provider factories from
+ProviderFactories, which points the test's providers at that server by passing the cassette's BasePath to them. Requests are replayed
+by method, path, query and body. OAuth token requests are never recorded; in replay mode the server hands out a
+dummy token.
+*/
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+const (
+	ModeEnvVar = "GENESYSCLOUD_HTTP_CASSETTE"
+	ModeRecord = "record"
+	ModeReplay = "replay"
+
+	oauthTokenPath = "/oauth/token"
+	redacted       = "REDACTED"
+)
+
+// Headers that are removed from recorded interactions
+var scrubbedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"Inin-Correlation-Id",
+	"Inin-Ratelimit-Count",
+	"Inin-Ratelimit-Reset",
+	"X-Amzn-Requestid",
+	"X-Amzn-Trace-Id",
+	"Date",
+}
+
+var scrubbedBodyFields = regexp.MustCompile(`"(access_token|contextId|correlationId)"\s*:\s*"[^"]*"`)
+
+// Interaction is a single recorded request and the response it received
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string `json:"method"`
+	Url    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Cassette holds the interactions of one test and serves them from a local server
+type Cassette struct {
+	Name         string        `json:"name"`
+	Interactions []Interaction `json:"interactions"`
+
+	mode   string
+	server *httptest.Server
+
+	mu       sync.Mutex
+	upstream string
+	// next interaction to replay for each request key
+	replayIndex map[string]int
+}
+
+// Mode returns the cassette mode set in the environment, or an empty string if cassettes are disabled
+func Mode() string {
+	switch mode := strings.ToLower(os.Getenv(ModeEnvVar)); mode {
+	case ModeRecord, ModeReplay:
+		return mode
+	default:
+		return ""
+	}
+}
+
+// IsReplaying is true when requests are answered from cassettes instead of Genesys Cloud
+func IsReplaying() bool {
+	return Mode() == ModeReplay
+}
+
+// Cassettes in use, by test. A test running several test cases shares one cassette between them.
+var testCassettes sync.Map
+
+// Use returns the cassette of t, or nil if cassettes are disabled. Its server is stopped when t finishes, and in
+// record mode a non-empty cassette is written to test/data/cassettes/<TestName>.json. In replay mode, t is skipped when it has
+// no cassette.
+func Use(t *testing.T) *Cassette {
+	mode := Mode()
+	if mode == "" {
+		return nil
+	}
+	if cassette, ok := testCassettes.Load(t); ok {
+		return cassette.(*Cassette)
+	}
+
+	name := strings.ReplaceAll(t.Name(), "/", "_")
+	path := filepath.Join(cassetteDir(), name+".json")
+
+	cassette := &Cassette{Name: name}
+	if mode == ModeReplay {
+		loaded, err := Load(path)
+		if os.IsNotExist(err) {
+			t.Skipf("No cassette recorded for %s in %s", t.Name(), path)
+		}
+		if err != nil {
+			t.Fatalf("Failed to load cassette %s: %v", path, err)
+		}
+		cassette = loaded
+	}
+	cassette.start(mode)
+	testCassettes.Store(t, cassette)
+
+	t.Cleanup(func() {
+		testCassettes.Delete(t)
+		cassette.server.Close()
+		if mode == ModeRecord && len(cassette.Interactions) > 0 {
+			if err := cassette.Save(path); err != nil {
+				t.Errorf("Failed to save cassette %s: %v", path, err)
+			}
+		}
+	})
+	return cassette
+}
+
+func (c *Cassette) start(mode string) {
+	c.mode = mode
+	c.server = httptest.NewServer(c)
+	log.Printf("HTTP cassette %s in %s mode listening on %s", c.Name, mode, c.server.URL)
+}
+
+// BasePath returns the base path the SDK should use to send requests through the cassette. While recording they are
+// forwarded to upstream; the first upstream a cassette is given is used for all of its requests.
+func (c *Cassette) BasePath(upstream string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.upstream == "" {
+		c.upstream = upstream
+	}
+	return c.server.URL
+}
+
+// URL returns the address of the cassette's server
+func (c *Cassette) URL() string {
+	return c.server.URL
+}
+
+func (c *Cassette) getUpstream() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.upstream
+}
+
+// ProviderFactories returns the provider factories of an acceptance test. When cassettes are enabled, the providers
+// and the SDK default configuration used by the test's checks send their requests through the test's own cassette.
+func ProviderFactories(t *testing.T, providerResources map[string]*schema.Resource, providerDataSources map[string]*schema.Resource) map[string]func() (*schema.Provider, error) {
+	cassette := Use(t)
+	if cassette == nil {
+		return provider.GetProviderFactories(providerResources, providerDataSources)
+	}
+
+	defaultConfig := platformclientv2.GetDefaultConfiguration()
+	basePath := defaultConfig.BasePath
+	defaultConfig.BasePath = cassette.URL()
+	t.Cleanup(func() {
+		defaultConfig.BasePath = basePath
+	})
+	return provider.GetProviderFactoriesWithBasePathFunc(providerResources, providerDataSources, cassette.BasePath)
+}
+
+// Load reads a cassette file
+func Load(path string) (*Cassette, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(contents, &cassette); err != nil {
+		return nil, err
+	}
+	return &cassette, nil
+}
+
+// Save writes the cassette to path, creating the directory if needed
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, contents, 0644)
+}
+
+func (c *Cassette) record(interaction Interaction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Interactions = append(c.Interactions, interaction)
+}
+
+// replay returns the next recorded interaction with the method, path, query and body of request. Interactions are
+// matched in the order they were recorded; once they run out the last one is repeated, which keeps polling reads stable.
+func (c *Cassette) replay(request *http.Request, body []byte) (*Interaction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.replayIndex == nil {
+		c.replayIndex = make(map[string]int)
+	}
+	key := requestKey(request.Method, request.URL.RequestURI(), scrubBody(string(body)))
+
+	var matches []int
+	for i, interaction := range c.Interactions {
+		if requestKey(interaction.Request.Method, interaction.Request.Url, interaction.Request.Body) == key {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, false
+	}
+
+	next := c.replayIndex[key]
+	if next >= len(matches) {
+		next = len(matches) - 1
+	}
+	c.replayIndex[key] = next + 1
+	return &c.Interactions[matches[next]], true
+}
+
+// ServeHTTP records a request forwarded to the upstream or replays the recorded response, depending on the mode
+func (c *Cassette) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == oauthTokenPath {
+		c.serveToken(w, r)
+		return
+	}
+
+	requestBody, _ := io.ReadAll(r.Body)
+	if c.mode == ModeReplay {
+		interaction, ok := c.replay(r, requestBody)
+		if !ok {
+			http.Error(w, fmt.Sprintf("no recorded interaction in cassette %s for %s %s", c.Name, r.Method, r.URL), http.StatusNotFound)
+			return
+		}
+		writeResponse(w, interaction.Response)
+		return
+	}
+
+	response, err := forward(r, c.getUpstream(), requestBody)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	c.record(Interaction{
+		Request: Request{
+			Method: r.Method,
+			Url:    r.URL.RequestURI(),
+			Body:   scrubBody(string(requestBody)),
+		},
+		Response: Response{
+			StatusCode: response.StatusCode,
+			Headers:    scrubHeaders(response.Headers),
+			Body:       scrubBody(response.Body),
+		},
+	})
+	writeResponse(w, *response)
+}
+
+// serveToken forwards token requests to the login host while recording and hands out a dummy token while replaying
+func (c *Cassette) serveToken(w http.ResponseWriter, r *http.Request) {
+	if c.mode == ModeReplay {
+		writeResponse(w, Response{
+			StatusCode: http.StatusOK,
+			Headers:    http.Header{"Content-Type": []string{"application/json"}},
+			Body:       `{"access_token":"` + redacted + `","token_type":"bearer","expires_in":86400}`,
+		})
+		return
+	}
+
+	requestBody, _ := io.ReadAll(r.Body)
+	loginHost := regexp.MustCompile(`(?i)\/\/api\.`).ReplaceAllString(c.getUpstream(), "//login.")
+	response, err := forward(r, loginHost, requestBody)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	writeResponse(w, *response)
+}
+
+func forward(r *http.Request, host string, body []byte) (*Response, error) {
+	request, err := http.NewRequest(r.Method, strings.TrimSuffix(host, "/")+r.URL.RequestURI(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header = r.Header.Clone()
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	headers := response.Header.Clone()
+	headers.Del("Content-Length")
+	headers.Del("Content-Encoding")
+	return &Response{
+		StatusCode: response.StatusCode,
+		Headers:    headers,
+		Body:       string(responseBody),
+	}, nil
+}
+
+func writeResponse(w http.ResponseWriter, response Response) {
+	for name, values := range response.Headers {
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	w.WriteHeader(response.StatusCode)
+	_, _ = w.Write([]byte(response.Body))
+}
+
+func scrubHeaders(headers http.Header) http.Header {
+	scrubbed := headers.Clone()
+	for _, name := range scrubbedHeaders {
+		scrubbed.Del(name)
+	}
+	return scrubbed
+}
+
+func scrubBody(body string) string {
+	return scrubbedBodyFields.ReplaceAllString(body, `"$1":"`+redacted+`"`)
+}
+
+// requestKey identifies a request for replay. Query parameters are sorted as the SDK builds them from a map.
+func requestKey(method string, requestUri string, body string) string {
+	path, rawQuery, _ := strings.Cut(requestUri, "?")
+	key := strings.ToUpper(method) + " " + path
+	if query, err := url.ParseQuery(rawQuery); err == nil && len(query) > 0 {
+		key += "?" + query.Encode()
+	} else if rawQuery != "" {
+		key += "?" + rawQuery
+	}
+	return key + "\n" + body
+}
+
+// cassetteDir returns test/data/cassettes in the root of the module, so that tests of every package share the
+// same directory regardless of their working directory
+func cassetteDir() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return filepath.Join("test", "data", "cassettes")
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return filepath.Join(dir, "test", "data", "cassettes")
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return filepath.Join("test", "data", "cassettes")
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,152 @@
+package cassette
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUnitCassetteRecordAndReplay(t *testing.T) {
+	upstreamRequests := 0
+	upstreamServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamRequests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Inin-Correlation-Id", "correlation-id")
+		_, _ = fmt.Fprintf(w, `{"id": "queue-id", "name": "queue %d", "expand": "%s", "contextId": "context-id"}`, upstreamRequests, r.URL.Query().Get("expand"))
+	}))
+	defer upstreamServer.Close()
+
+	recorded := &Cassette{Name: t.Name()}
+	recorded.start(ModeRecord)
+	basePath := recorded.BasePath(upstreamServer.URL)
+	if basePath == upstreamServer.URL {
+		t.Fatalf("expected base path to point at the cassette server")
+	}
+	for i := 1; i <= 2; i++ {
+		if body := get(t, basePath+"/api/v2/routing/queues/queue-id?expand=members&pageSize=1", http.Header{"Authorization": []string{"Bearer secret"}}); !strings.Contains(body, fmt.Sprintf("queue %d", i)) {
+			t.Fatalf("expected recorded response to be passed through, got %s", body)
+		}
+	}
+	if body := get(t, basePath+"/api/v2/routing/queues/queue-id?expand=wrapupcodes", nil); !strings.Contains(body, "queue 3") {
+		t.Fatalf("expected recorded response to be passed through, got %s", body)
+	}
+	recorded.server.Close()
+
+	if len(recorded.Interactions) != 3 {
+		t.Fatalf("expected 3 recorded interactions, got %d", len(recorded.Interactions))
+	}
+	for _, interaction := range recorded.Interactions {
+		if interaction.Response.Headers.Get("Inin-Correlation-Id") != "" {
+			t.Errorf("expected correlation id header to be scrubbed")
+		}
+		if strings.Contains(interaction.Response.Body, "context-id") {
+			t.Errorf("expected context id to be scrubbed from %s", interaction.Response.Body)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := recorded.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	replayed, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	upstreamServer.Close()
+
+	replayed.start(ModeReplay)
+	defer replayed.server.Close()
+	basePath = replayed.BasePath(upstreamServer.URL)
+
+	token := post(t, basePath+oauthTokenPath)
+	if !strings.Contains(token, redacted) {
+		t.Errorf("expected a dummy token while replaying, got %s", token)
+	}
+	// Query parameters are matched regardless of their order
+	for _, expected := range []string{"queue 1", "queue 2", "queue 2"} {
+		if body := get(t, basePath+"/api/v2/routing/queues/queue-id?pageSize=1&expand=members", nil); !strings.Contains(body, expected) {
+			t.Errorf("expected replayed response to contain %q, got %s", expected, body)
+		}
+	}
+	if body := get(t, basePath+"/api/v2/routing/queues/queue-id?expand=wrapupcodes", nil); !strings.Contains(body, "queue 3") {
+		t.Errorf("expected replayed response to match on the query, got %s", body)
+	}
+	if body := get(t, basePath+"/api/v2/routing/queues/queue-id", nil); !strings.Contains(body, "no recorded interaction") {
+		t.Errorf("expected no interaction to match a request with a different query, got %s", body)
+	}
+}
+
+func TestUnitCassetteReplayMatchesBody(t *testing.T) {
+	replayed := &Cassette{
+		Name: t.Name(),
+		Interactions: []Interaction{
+			{Request: Request{Method: http.MethodPost, Url: "/api/v2/routing/skills", Body: `{"name":"skill 1"}`}, Response: Response{StatusCode: http.StatusOK, Body: `{"id":"skill-1"}`}},
+			{Request: Request{Method: http.MethodPost, Url: "/api/v2/routing/skills", Body: `{"name":"skill 2"}`}, Response: Response{StatusCode: http.StatusOK, Body: `{"id":"skill-2"}`}},
+		},
+	}
+	replayed.start(ModeReplay)
+	defer replayed.server.Close()
+
+	request, _ := http.NewRequest(http.MethodPost, replayed.server.URL+"/api/v2/routing/skills", strings.NewReader(`{"name":"skill 2"}`))
+	if body := do(t, request); body != `{"id":"skill-2"}` {
+		t.Errorf("expected the interaction with the same body to be replayed, got %s", body)
+	}
+}
+
+func TestUnitCassetteUseSkipsTestsWithoutCassette(t *testing.T) {
+	t.Setenv(ModeEnvVar, ModeReplay)
+
+	var skipped bool
+	t.Run("NotRecorded", func(t *testing.T) {
+		defer func() { skipped = t.Skipped() }()
+		Use(t)
+		t.Error("expected a test without cassette to be skipped in replay mode")
+	})
+	if !skipped {
+		t.Error("expected the test to be skipped")
+	}
+}
+
+func TestUnitCassetteUseOncePerTest(t *testing.T) {
+	t.Setenv(ModeEnvVar, ModeRecord)
+
+	var shared *testing.T
+	t.Run("Shared", func(t *testing.T) {
+		shared = t
+		first := Use(t)
+		if second := Use(t); second != first {
+			t.Error("expected the test cases of a test to share its cassette")
+		}
+		// Nothing is recorded, so nothing is written on cleanup
+	})
+	if _, ok := testCassettes.Load(shared); ok {
+		t.Error("expected the cassette to be released when the test finished")
+	}
+}
+
+func get(t *testing.T, url string, headers http.Header) string {
+	request, _ := http.NewRequest(http.MethodGet, url, nil)
+	for name, values := range headers {
+		request.Header[name] = values
+	}
+	return do(t, request)
+}
+
+func post(t *testing.T, url string) string {
+	request, _ := http.NewRequest(http.MethodPost, url, strings.NewReader("grant_type=client_credentials"))
+	return do(t, request)
+}
+
+func do(t *testing.T, request *http.Request) string {
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	body, _ := io.ReadAll(response.Body)
+	return string(body)
+}
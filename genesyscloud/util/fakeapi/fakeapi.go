@@ -0,0 +1,443 @@
+/*
+Package fakeapi provides an in-process fake of the Genesys Cloud public API for unit tests.
+
+The server keeps generic in-memory stores for the collections registered in defaultCollections and implements
+create, read, update, delete and paged listing for them. Pointing a client config at the server (see ClientConfig)
+lets resource functions run full create/read/update/delete/import cycles without stubbing their proxies.
+
+Faults can be injected per method and path to exercise retry logic such as util.RetryWhen and
+util.WithRetriesForRead.
+*/
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+)
+
+// Collection describes an API collection served by the fake server
+type Collection struct {
+	// Path of the collection, e.g. /api/v2/routing/queues
+	Path string
+	// IdField is the property identifying an entity. Defaults to "id".
+	IdField string
+	// NestedCollections are collections below each entity, e.g. the rows of a datatable
+	NestedCollections []Collection
+	// OnCreate can fill in server generated properties of a new entity
+	OnCreate func(entity Entity)
+}
+
+// Entity is a JSON object held in a store
+type Entity map[string]interface{}
+
+var defaultCollections = []Collection{
+	{Path: "/api/v2/users"},
+	{Path: "/api/v2/routing/queues"},
+	{Path: "/api/v2/routing/skills"},
+	{Path: "/api/v2/routing/wrapupcodes"},
+	{Path: "/api/v2/authorization/divisions"},
+	{
+		Path: "/api/v2/flows/jobs",
+		OnCreate: func(entity Entity) {
+			if _, ok := entity["status"]; !ok {
+				entity["status"] = "Success"
+			}
+		},
+	},
+	{
+		Path:              "/api/v2/flows/datatables",
+		NestedCollections: []Collection{{Path: "rows", IdField: "key"}},
+	},
+}
+
+// Fault makes the server answer matching requests with an error instead of handling them
+type Fault struct {
+	// Method to match. Matches every method if empty.
+	Method string
+	// PathPrefix to match. Matches every path if empty.
+	PathPrefix string
+	StatusCode int
+	// Times the fault is returned before requests are handled normally again. Zero means forever.
+	Times int
+	// RetryAfter is sent as the Retry-After header in seconds
+	RetryAfter int
+}
+
+type store struct {
+	collection Collection
+	entities   map[string]Entity
+	order      []string
+}
+
+// Server is an httptest.Server serving the fake API
+type Server struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	collections   map[string]Collection
+	stores        map[string]*store
+	faults        []*Fault
+	requestCounts map[string]int
+	homeDivision  Entity
+}
+
+// New starts a fake API server with the default collections and a home division. The server is closed when the
+// test finishes.
+func New(t *testing.T) *Server {
+	s := &Server{
+		collections:   make(map[string]Collection),
+		stores:        make(map[string]*store),
+		requestCounts: make(map[string]int),
+	}
+	for _, collection := range defaultCollections {
+		s.AddCollection(collection)
+	}
+	s.homeDivision = s.Create("/api/v2/authorization/divisions", Entity{"name": "Home", "homeDivision": true})
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// ClientConfig returns an SDK config that sends its requests to the fake server. Retries are kept short so that
+// injected faults don't slow tests down.
+func (s *Server) ClientConfig() *platformclientv2.Configuration {
+	config := platformclientv2.NewConfiguration()
+	config.BasePath = s.URL
+	config.AccessToken = "fake-access-token"
+	config.RetryConfiguration = &platformclientv2.RetryConfiguration{
+		RetryWaitMin: 10 * time.Millisecond,
+		RetryWaitMax: 50 * time.Millisecond,
+		RetryMax:     5,
+	}
+	return config
+}
+
+// AddCollection registers an additional collection
+func (s *Server) AddCollection(collection Collection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if collection.IdField == "" {
+		collection.IdField = "id"
+	}
+	s.collections[collection.Path] = collection
+}
+
+// HomeDivisionId returns the id of the seeded home division
+func (s *Server) HomeDivisionId() string {
+	return s.homeDivision["id"].(string)
+}
+
+// Create adds an entity to the collection at collectionPath as if it was posted to the API and returns it
+func (s *Server) Create(collectionPath string, entity Entity) Entity {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.getStore(collectionPath)
+	if !ok {
+		panic(fmt.Sprintf("fakeapi: unknown collection %s", collectionPath))
+	}
+	return st.create(collectionPath, entity)
+}
+
+// Get returns the entity with the given id, if it exists
+func (s *Server) Get(collectionPath string, id string) (Entity, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.getStore(collectionPath)
+	if !ok {
+		return nil, false
+	}
+	entity, ok := st.entities[id]
+	return entity, ok
+}
+
+// InjectFault adds a fault. Faults are matched in the order they were injected.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// RequestCount returns how many requests were received for the given method and path, including faulted ones
+func (s *Server) RequestCount(method string, requestPath string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requestCounts[method+" "+requestPath]
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requestCounts[r.Method+" "+r.URL.Path]++
+	if fault := s.matchFault(r); fault != nil {
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(fault.RetryAfter))
+		}
+		writeError(w, fault.StatusCode, "injected fault")
+		return
+	}
+
+	if r.URL.Path == "/oauth/token" {
+		writeJson(w, http.StatusOK, Entity{"access_token": "fake-access-token", "token_type": "bearer", "expires_in": 86400})
+		return
+	}
+	if r.URL.Path == "/api/v2/authorization/divisions/home" && r.Method == http.MethodGet {
+		writeJson(w, http.StatusOK, s.homeDivision)
+		return
+	}
+
+	requestPath := strings.TrimSuffix(r.URL.Path, "/")
+	if st, ok := s.getStore(requestPath); ok {
+		s.serveCollection(w, r, requestPath, st)
+		return
+	}
+	if st, ok := s.getStore(path.Dir(requestPath)); ok {
+		s.serveEntity(w, r, st, path.Base(requestPath))
+		return
+	}
+	writeError(w, http.StatusNotFound, fmt.Sprintf("no fake route for %s %s", r.Method, r.URL.Path))
+}
+
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, collectionPath string, st *store) {
+	switch r.Method {
+	case http.MethodPost:
+		entity, err := readEntity(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeJson(w, http.StatusOK, st.create(collectionPath, entity))
+	case http.MethodGet:
+		writeJson(w, http.StatusOK, st.list(r))
+	default:
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s not supported on %s", r.Method, collectionPath))
+	}
+}
+
+func (s *Server) serveEntity(w http.ResponseWriter, r *http.Request, st *store, id string) {
+	existing, ok := st.entities[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("entity %s not found", id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJson(w, http.StatusOK, existing)
+	case http.MethodPut, http.MethodPatch:
+		update, err := readEntity(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if version, ok := update["version"]; ok && fmt.Sprint(version) != fmt.Sprint(existing["version"]) {
+			writeError(w, http.StatusConflict, fmt.Sprintf("version %v does not match the current version %v", version, existing["version"]))
+			return
+		}
+		writeJson(w, http.StatusOK, st.update(id, update, r.Method == http.MethodPut))
+	case http.MethodDelete:
+		st.delete(id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s not supported on %s", r.Method, r.URL.Path))
+	}
+}
+
+// getStore returns the store of the collection at collectionPath. Stores of nested collections are created on
+// first use as long as their parent entity exists.
+func (s *Server) getStore(collectionPath string) (*store, bool) {
+	if st, ok := s.stores[collectionPath]; ok {
+		return st, true
+	}
+	if collection, ok := s.collections[collectionPath]; ok {
+		s.stores[collectionPath] = newStore(collection)
+		return s.stores[collectionPath], true
+	}
+
+	// Nested collections look like <parent collection>/<parent id>/<nested path>
+	entityPath, nestedPath := path.Dir(collectionPath), path.Base(collectionPath)
+	parentPath, parentId := path.Dir(entityPath), path.Base(entityPath)
+	parent, ok := s.stores[parentPath]
+	if !ok {
+		return nil, false
+	}
+	if _, ok := parent.entities[parentId]; !ok {
+		return nil, false
+	}
+	for _, nested := range parent.collection.NestedCollections {
+		if nested.Path == nestedPath {
+			if nested.IdField == "" {
+				nested.IdField = "id"
+			}
+			s.stores[collectionPath] = newStore(nested)
+			return s.stores[collectionPath], true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) matchFault(r *http.Request) *Fault {
+	for i, fault := range s.faults {
+		if fault.Method != "" && !strings.EqualFold(fault.Method, r.Method) {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, fault.PathPrefix) {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+func newStore(collection Collection) *store {
+	return &store{collection: collection, entities: make(map[string]Entity)}
+}
+
+func (st *store) create(collectionPath string, entity Entity) Entity {
+	id, _ := entity[st.collection.IdField].(string)
+	if id == "" {
+		id = uuid.NewString()
+		entity[st.collection.IdField] = id
+	}
+	entity["selfUri"] = collectionPath + "/" + id
+	entity["version"] = 1
+	if st.collection.OnCreate != nil {
+		st.collection.OnCreate(entity)
+	}
+	if _, exists := st.entities[id]; !exists {
+		st.order = append(st.order, id)
+	}
+	st.entities[id] = entity
+	return entity
+}
+
+func (st *store) update(id string, update Entity, replace bool) Entity {
+	existing := st.entities[id]
+	updated := Entity{}
+	if !replace {
+		for k, v := range existing {
+			updated[k] = v
+		}
+	}
+	for k, v := range update {
+		updated[k] = v
+	}
+	updated[st.collection.IdField] = id
+	updated["selfUri"] = existing["selfUri"]
+	updated["version"] = toInt(existing["version"]) + 1
+	st.entities[id] = updated
+	return updated
+}
+
+func (st *store) delete(id string) {
+	delete(st.entities, id)
+	for i, existingId := range st.order {
+		if existingId == id {
+			st.order = append(st.order[:i], st.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// list returns a page of entities in creation order, optionally filtered by name
+func (st *store) list(r *http.Request) Entity {
+	query := r.URL.Query()
+	pageSize := toIntOrDefault(query.Get("pageSize"), 25)
+	pageNumber := toIntOrDefault(query.Get("pageNumber"), 1)
+	name := query.Get("name")
+
+	var matches []Entity
+	for _, id := range st.order {
+		entity := st.entities[id]
+		if name != "" && !strings.EqualFold(fmt.Sprint(entity["name"]), strings.Trim(name, "*")) {
+			continue
+		}
+		matches = append(matches, entity)
+	}
+
+	start := (pageNumber - 1) * pageSize
+	end := start + pageSize
+	if start > len(matches) {
+		start = len(matches)
+	}
+	if end > len(matches) {
+		end = len(matches)
+	}
+	pageCount := (len(matches) + pageSize - 1) / pageSize
+
+	return Entity{
+		"entities":   matches[start:end],
+		"pageSize":   pageSize,
+		"pageNumber": pageNumber,
+		"total":      len(matches),
+		"pageCount":  pageCount,
+	}
+}
+
+func readEntity(r *http.Request) (Entity, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	entity := Entity{}
+	if len(body) == 0 {
+		return entity, nil
+	}
+	if err := json.Unmarshal(body, &entity); err != nil {
+		return nil, fmt.Errorf("invalid request body: %v", err)
+	}
+	return entity, nil
+}
+
+func writeJson(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes an error in the format of the Genesys Cloud API so that the SDK populates APIResponse.Error
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJson(w, statusCode, Entity{
+		"message":   message,
+		"code":      strings.ToLower(strings.ReplaceAll(http.StatusText(statusCode), " ", ".")),
+		"status":    statusCode,
+		"contextId": uuid.NewString(),
+	})
+}
+
+func toInt(value interface{}) int {
+	switch v := value.(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	default:
+		return 0
+	}
+}
+
+func toIntOrDefault(value string, defaultValue int) int {
+	if i, err := strconv.Atoi(value); err == nil && i > 0 {
+		return i
+	}
+	return defaultValue
+}
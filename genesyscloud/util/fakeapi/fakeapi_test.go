@@ -0,0 +1,82 @@
+package fakeapi
+
+import (
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v131/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitFakeApiQueueCrud(t *testing.T) {
+	server := New(t)
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(server.ClientConfig())
+
+	name := "Unit Test Queue"
+	queue, _, err := routingAPI.PostRoutingQueues(platformclientv2.Createqueuerequest{Name: &name})
+	assert.Nil(t, err)
+	assert.NotNil(t, queue.Id)
+
+	read, _, err := routingAPI.GetRoutingQueue(*queue.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, name, *read.Name)
+
+	queues, _, err := routingAPI.GetRoutingQueues(1, 25, "", name, nil, nil, nil, "", false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(*queues.Entities))
+
+	newName := "Renamed Unit Test Queue"
+	updated, _, err := routingAPI.PutRoutingQueue(*queue.Id, platformclientv2.Queuerequest{Name: &newName})
+	assert.Nil(t, err)
+	assert.Equal(t, newName, *updated.Name)
+
+	_, err = routingAPI.DeleteRoutingQueue(*queue.Id, false)
+	assert.Nil(t, err)
+
+	_, resp, err := routingAPI.GetRoutingQueue(*queue.Id)
+	assert.NotNil(t, err)
+	assert.True(t, util.IsStatus404(resp))
+}
+
+func TestUnitFakeApiNestedDatatableRows(t *testing.T) {
+	server := New(t)
+	datatable := server.Create("/api/v2/flows/datatables", Entity{"name": "Unit Test Datatable"})
+	rowsPath := "/api/v2/flows/datatables/" + datatable["id"].(string) + "/rows"
+
+	server.Create(rowsPath, Entity{"key": "row-1", "value": "a"})
+	row, ok := server.Get(rowsPath, "row-1")
+	assert.True(t, ok)
+	assert.Equal(t, "a", row["value"])
+
+	_, ok = server.Get("/api/v2/flows/datatables/missing/rows", "row-1")
+	assert.False(t, ok, "rows of a datatable that doesn't exist should not be served")
+}
+
+func TestUnitFakeApiFaultInjection(t *testing.T) {
+	server := New(t)
+	wrapupcode := server.Create("/api/v2/routing/wrapupcodes", Entity{"name": "Unit Test Wrapup Code"})
+	wrapupcodeId := wrapupcode["id"].(string)
+	wrapupcodePath := "/api/v2/routing/wrapupcodes/" + wrapupcodeId
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(server.ClientConfig())
+
+	// 429s are retried by the SDK itself
+	server.InjectFault(Fault{Method: http.MethodGet, PathPrefix: wrapupcodePath, StatusCode: http.StatusTooManyRequests, Times: 2})
+	_, _, err := routingAPI.GetRoutingWrapupcode(wrapupcodeId)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, server.RequestCount(http.MethodGet, wrapupcodePath))
+
+	// 409s are retried by util.RetryWhen
+	server.InjectFault(Fault{Method: http.MethodPut, PathPrefix: wrapupcodePath, StatusCode: http.StatusConflict, Times: 1})
+	name := "Renamed Unit Test Wrapup Code"
+	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		_, resp, err := routingAPI.PutRoutingWrapupcode(wrapupcodeId, platformclientv2.Wrapupcoderequest{Name: &name})
+		if err != nil {
+			return resp, diag.FromErr(err)
+		}
+		return resp, nil
+	})
+	assert.Nil(t, diagErr)
+	assert.Equal(t, 2, server.RequestCount(http.MethodPut, wrapupcodePath))
+}
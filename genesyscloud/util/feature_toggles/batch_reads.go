@@ -0,0 +1,15 @@
+package feature_toggles
+
+import "os"
+
+const batchReadsEnvToggle = "ENABLE_BATCH_READS"
+
+func BatchReadsToggleName() string {
+	return batchReadsEnvToggle
+}
+
+func BatchReadsToggleExists() bool {
+	var exists bool
+	_, exists = os.LookupEnv(batchReadsEnvToggle)
+	return exists
+}
@@ -60,16 +60,21 @@ func NewS3Uploader(reader io.Reader, formData map[string]io.Reader, substitution
 func (s *S3Uploader) substituteValues() {
 	// Attribute specific to the flows resource
 	if s.substitutions != nil && len(s.substitutions) > 0 {
-		fileContents := s.bodyBuf.String()
-		for k, v := range s.substitutions {
-			fileContents = strings.Replace(fileContents, fmt.Sprintf("{{%s}}", k), v.(string), -1)
-		}
+		fileContents := SubstituteValues(s.bodyBuf.String(), s.substitutions)
 
 		s.bodyBuf.Reset()
 		s.bodyBuf.WriteString(fileContents)
 	}
 }
 
+// SubstituteValues replaces the {{key}} placeholders of a file with the values of the substitutions
+func SubstituteValues(fileContents string, substitutions map[string]interface{}) string {
+	for k, v := range substitutions {
+		fileContents = strings.Replace(fileContents, fmt.Sprintf("{{%s}}", k), v.(string), -1)
+	}
+	return fileContents
+}
+
 func (s *S3Uploader) Upload() ([]byte, error) {
 	return s.UploadFunc(s)
 }
@@ -266,10 +271,7 @@ func prepareAndUploadFile(filename string, substitutions map[string]interface{},
 
 	// Attribute specific to the flows resource
 	if len(substitutions) > 0 {
-		fileContents := bodyBuf.String()
-		for k, v := range substitutions {
-			fileContents = strings.Replace(fileContents, fmt.Sprintf("{{%s}}", k), v.(string), -1)
-		}
+		fileContents := SubstituteValues(bodyBuf.String(), substitutions)
 
 		bodyBuf.Reset()
 		bodyBuf.WriteString(fileContents)
@@ -36,3 +36,12 @@ func StringExists(target string, slice []string) bool {
 	}
 	return false
 }
+
+// SanitizeE164Number removes the formatting characters of an e164 number e.g. +(1) 111-222-333 --> +1111222333
+func SanitizeE164Number(number string) string {
+	charactersToRemove := []string{" ", "-", "(", ")"}
+	for _, c := range charactersToRemove {
+		number = strings.Replace(number, c, "", -1)
+	}
+	return number
+}
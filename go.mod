@@ -1,6 +1,6 @@
 module terraform-provider-genesyscloud
 
-go 1.20
+go 1.21
 
 require (
 	github.com/google/go-cmp v0.6.0
@@ -16,6 +16,7 @@ require (
 	github.com/rjNemo/underscore v0.6.1
 	github.com/zclconf/go-cty v1.14.4
 	gonum.org/v1/gonum v0.15.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -38,7 +39,6 @@ require (
 	golang.org/x/sync v0.7.0 // indirect
 	golang.org/x/tools v0.21.1-0.20240508182429-e35e4ccd0d2d // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240227224415-6ceb2ff114de // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 require (
@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"log"
 	"sync"
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
@@ -16,6 +17,8 @@ import (
 	userPrompt "terraform-provider-genesyscloud/genesyscloud/architect_user_prompt"
 	authRole "terraform-provider-genesyscloud/genesyscloud/auth_role"
 	authorizatioProduct "terraform-provider-genesyscloud/genesyscloud/authorization_product"
+	consistencyChecker "terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	dependencyConsumers "terraform-provider-genesyscloud/genesyscloud/dependency_consumers"
 	employeeperformanceExternalmetricsDefinition "terraform-provider-genesyscloud/genesyscloud/employeeperformance_externalmetrics_definitions"
 	externalContacts "terraform-provider-genesyscloud/genesyscloud/external_contacts"
 	flowLogLevel "terraform-provider-genesyscloud/genesyscloud/flow_loglevel"
@@ -53,6 +56,7 @@ import (
 	pat "terraform-provider-genesyscloud/genesyscloud/process_automation_trigger"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	recMediaRetPolicy "terraform-provider-genesyscloud/genesyscloud/recording_media_retention_policy"
+	rc "terraform-provider-genesyscloud/genesyscloud/resource_cache"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 	respmanagementLibrary "terraform-provider-genesyscloud/genesyscloud/responsemanagement_library"
@@ -78,6 +82,7 @@ import (
 	worktype "terraform-provider-genesyscloud/genesyscloud/task_management_worktype"
 	"terraform-provider-genesyscloud/genesyscloud/team"
 	"terraform-provider-genesyscloud/genesyscloud/telephony"
+	numberInventory "terraform-provider-genesyscloud/genesyscloud/telephony_number_inventory"
 	did "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did"
 	didPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did_pool"
 	edgeGroup "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_edge_group"
@@ -138,6 +143,12 @@ func main() {
 		opts.ProviderAddr = "registry.terraform.io/mypurecloud/genesyscloud"
 	}
 	plugin.Serve(opts)
+
+	// Errors found while the consistency checker is bypassed are only written once the provider has shut down
+	if err := consistencyChecker.WriteConsistencyReport(); err != nil {
+		log.Printf("Failed to write consistency report: %v", err)
+	}
+	rc.LogBatchReadStats()
 }
 
 type RegisterInstance struct {
@@ -152,6 +163,7 @@ func registerResources() {
 	oauth.SetRegistrar(regInstance)                                        //Registering oauth_client
 	dt.SetRegistrar(regInstance)                                           //Registering architect data table
 	dtr.SetRegistrar(regInstance)                                          //Registering architect data table row
+	dependencyConsumers.SetRegistrar(regInstance)                          //Registering dependency consumers
 	emergencyGroup.SetRegistrar(regInstance)                               //Registering architect emergency group
 	architectSchedulegroups.SetRegistrar(regInstance)                      //Registering architect schedule groups
 	architectSchedules.SetRegistrar(regInstance)                           //Registering architect schedules
@@ -202,6 +214,7 @@ func registerResources() {
 	routingEmailRoute.SetRegistrar(regInstance)                            //Registering routing email route
 	did.SetRegistrar(regInstance)                                          //Registering telephony did
 	didPool.SetRegistrar(regInstance)                                      //Registering telephony did pools
+	numberInventory.SetRegistrar(regInstance)                              //Registering telephony number inventory
 	archIvr.SetRegistrar(regInstance)                                      //Registering architect ivr
 	workbin.SetRegistrar(regInstance)                                      //Registering task management workbin
 	workitemSchema.SetRegistrar(regInstance)                               //Registering task management workitem schema